@@ -0,0 +1,14 @@
+data "dependencytrack_about" "server" {}
+
+# Fail early when the server is too old for a feature
+resource "dependencytrack_project" "example" {
+  name    = "example"
+  version = "1.0.0"
+
+  lifecycle {
+    precondition {
+      condition     = data.dependencytrack_about.server.major_version > 4 || data.dependencytrack_about.server.minor_version >= 12
+      error_message = "Dependency-Track 4.12 or newer is required, found ${data.dependencytrack_about.server.version}."
+    }
+  }
+}
@@ -0,0 +1,15 @@
+data "dependencytrack_team" "developers" {
+  name = "Developers"
+}
+
+data "dependencytrack_acl_mappings" "developers" {
+  team = data.dependencytrack_team.developers.id
+}
+
+# Projects the team can access that are not in the expected set
+output "unexpected_projects" {
+  value = setsubtract(
+    data.dependencytrack_acl_mappings.developers.projects,
+    ["d3c8a5a0-1b2f-4c6e-9f0a-2b7d8e9f1a2b"],
+  )
+}
@@ -0,0 +1,24 @@
+# Look up a CWE by number
+data "dependencytrack_cwe" "xss" {
+  cwe_id = 79
+}
+
+# Look up a CWE by name
+data "dependencytrack_cwe" "sql_injection" {
+  name = "Improper Neutralization of Special Elements used in an SQL Command ('SQL Injection')"
+}
+
+# Reference the CWEs in a CWE policy condition
+resource "dependencytrack_policy" "injection" {
+  name            = "No Injection Weaknesses"
+  operator        = "ANY"
+  violation_state = "FAIL"
+
+  conditions = [
+    {
+      subject  = "CWE"
+      operator = "CONTAINS_ANY"
+      value    = join(",", [data.dependencytrack_cwe.xss.id, data.dependencytrack_cwe.sql_injection.id])
+    }
+  ]
+}
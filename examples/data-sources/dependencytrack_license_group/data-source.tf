@@ -7,3 +7,18 @@ data "dependencytrack_license_group" "by_name" {
 data "dependencytrack_license_group" "by_id" {
   id = "00000000-0000-0000-0000-000000000000"
 }
+
+# Reference the group by name in a LICENSE_GROUP policy condition
+resource "dependencytrack_policy" "copyleft" {
+  name            = "No Copyleft"
+  operator        = "ANY"
+  violation_state = "FAIL"
+
+  conditions = [
+    {
+      subject  = "LICENSE_GROUP"
+      operator = "IS"
+      value    = data.dependencytrack_license_group.by_name.id
+    }
+  ]
+}
@@ -0,0 +1,17 @@
+data "dependencytrack_managed_users" "all" {}
+
+# Audit the accounts that are currently suspended
+output "suspended_users" {
+  value = [
+    for user in data.dependencytrack_managed_users.all.users : user.username
+    if user.suspended
+  ]
+}
+
+# Users holding administrative permissions directly
+output "admins" {
+  value = [
+    for user in data.dependencytrack_managed_users.all.users : user.username
+    if contains(user.permissions, "ACCESS_MANAGEMENT")
+  ]
+}
@@ -6,4 +6,9 @@ data "dependencytrack_notification_publisher" "by_uuid" {
 # Look up a notification publisher by name
 data "dependencytrack_notification_publisher" "by_name" {
   name = "Slack Webhook"
-}
\ No newline at end of file
+}
+# Look up the built-in webhook publisher by its class
+# (use the extension name, e.g. "webhook", on Dependency-Track v5)
+data "dependencytrack_notification_publisher" "by_class" {
+  publisher_class = "org.dependencytrack.notification.publisher.WebhookPublisher"
+}
@@ -0,0 +1,10 @@
+# Look up a notification rule managed outside of Terraform
+data "dependencytrack_notification_rule" "example" {
+  name = "Critical Vulnerabilities"
+}
+
+# Attach a project to the existing rule
+resource "dependencytrack_notification_rule_project" "example" {
+  rule    = data.dependencytrack_notification_rule.example.uuid
+  project = "00000000-0000-0000-0000-000000000000"
+}
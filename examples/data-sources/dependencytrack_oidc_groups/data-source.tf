@@ -0,0 +1,15 @@
+data "dependencytrack_oidc_groups" "all" {}
+
+locals {
+  oidc_group_ids = { for group in data.dependencytrack_oidc_groups.all.groups : group.name => group.id }
+}
+
+resource "dependencytrack_team" "platform" {
+  name = "Platform"
+}
+
+# Map an OIDC group the identity provider reported to a team by name
+resource "dependencytrack_oidc_group_mapping" "platform" {
+  group = local.oidc_group_ids["platform-engineers"]
+  team  = dependencytrack_team.platform.id
+}
@@ -0,0 +1,14 @@
+# Who can upload BOMs?
+data "dependencytrack_permission" "bom_upload" {
+  name = "BOM_UPLOAD"
+}
+
+output "bom_upload_teams" {
+  value = [for team in data.dependencytrack_permission.bom_upload.teams : team.name]
+}
+
+output "bom_upload_users" {
+  value = [
+    for user in data.dependencytrack_permission.bom_upload.users : "${user.username} (${user.type})"
+  ]
+}
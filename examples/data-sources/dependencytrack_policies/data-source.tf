@@ -0,0 +1,16 @@
+data "dependencytrack_policies" "all" {}
+
+# Policies that fail builds
+output "failing_policies" {
+  value = [
+    for policy in data.dependencytrack_policies.all.policies : policy.name
+    if policy.violation_state == "FAIL"
+  ]
+}
+
+# Look up every policy, e.g. to migrate them one by one
+data "dependencytrack_policy" "each" {
+  for_each = { for policy in data.dependencytrack_policies.all.policies : policy.uuid => policy }
+
+  id = each.key
+}
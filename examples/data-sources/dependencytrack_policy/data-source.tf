@@ -1,4 +1,16 @@
 # Look up a policy by UUID
 data "dependencytrack_policy" "example" {
   id = "00000000-0000-0000-0000-000000000000"
-}
\ No newline at end of file
+}
+# Look up a policy by name
+data "dependencytrack_policy" "by_name" {
+  name = "License Policy"
+}
+
+# List the policy's conditions along with their UUIDs
+output "license_policy_conditions" {
+  value = {
+    for condition in data.dependencytrack_policy.by_name.conditions :
+    condition.uuid => "${condition.subject} ${condition.operator} ${condition.value}"
+  }
+}
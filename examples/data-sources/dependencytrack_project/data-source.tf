@@ -7,4 +7,16 @@ data "dependencytrack_project" "by_uuid" {
 data "dependencytrack_project" "by_name_version" {
   name    = "My Application"
   version = "1.0.0"
-}
\ No newline at end of file
+}
+
+# Look up a project by the PURL of its SBOM's metadata.component
+data "dependencytrack_project" "by_purl" {
+  purl = "pkg:maven/com.example/my-application@1.0.0"
+}
+
+# Look up each direct child of a project
+data "dependencytrack_project" "children" {
+  for_each = data.dependencytrack_project.by_name_version.children
+
+  id = each.value
+}
@@ -0,0 +1,15 @@
+data "dependencytrack_project" "web_app" {
+  name    = "Web Application"
+  version = "1.0.0"
+}
+
+# Export the project's current CycloneDX BOM, including vulnerabilities
+# and their analyses (VEX)
+data "dependencytrack_project_bom" "web_app" {
+  project = data.dependencytrack_project.web_app.id
+  variant = "withVulnerabilities"
+}
+
+output "web_app_component_count" {
+  value = length(jsondecode(data.dependencytrack_project_bom.web_app.bom).components)
+}
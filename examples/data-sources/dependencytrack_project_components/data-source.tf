@@ -0,0 +1,13 @@
+data "dependencytrack_project" "web_app" {
+  name    = "Web Application"
+  version = "1.0.0"
+}
+
+# Fetch all components of the project
+data "dependencytrack_project_components" "web_app" {
+  project = data.dependencytrack_project.web_app.id
+}
+
+output "web_app_component_purls" {
+  value = toset(data.dependencytrack_project_components.web_app.components[*].purl)
+}
@@ -6,6 +6,11 @@ data "dependencytrack_project" "web_app" {
 # Fetch the latest metrics snapshot of the project
 data "dependencytrack_project_metrics" "web_app" {
   project = data.dependencytrack_project.web_app.id
+
+  # Wait up to five minutes for a first metrics refresh of a new project
+  timeouts {
+    read = "5m"
+  }
 }
 
 output "web_app_critical_vulnerabilities" {
@@ -0,0 +1,10 @@
+# Read the build number recorded on a project
+data "dependencytrack_project_property" "build_number" {
+  project = "00000000-0000-0000-0000-000000000000"
+  group   = "build"
+  name    = "number"
+}
+
+output "build_number" {
+  value = data.dependencytrack_project_property.build_number.value
+}
@@ -0,0 +1,13 @@
+data "dependencytrack_project" "web_app" {
+  name    = "Web Application"
+  version = "1.0.0"
+}
+
+# Fetch all services declared in the project's BOM
+data "dependencytrack_project_services" "web_app" {
+  project = data.dependencytrack_project.web_app.id
+}
+
+output "web_app_service_endpoints" {
+  value = toset(flatten(data.dependencytrack_project_services.web_app.services[*].endpoints))
+}
@@ -17,3 +17,8 @@ data "dependencytrack_project_violations" "web_app_all" {
 output "web_app_violated_policies" {
   value = toset(data.dependencytrack_project_violations.web_app.violations[*].policy_name)
 }
+
+# Report outstanding policy failures for the release
+output "web_app_failing_violations" {
+  value = data.dependencytrack_project_violations.web_app.state_counts["FAIL"]
+}
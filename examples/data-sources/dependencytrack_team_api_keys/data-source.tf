@@ -14,6 +14,8 @@ output "api_keys" {
       comment    = key.comment
       masked_key = key.masked_key
       legacy     = key.legacy
+      created    = key.created   # epoch milliseconds
+      last_used  = key.last_used # null if the key was never used
     }
   ]
 }
\ No newline at end of file
@@ -0,0 +1,14 @@
+data "dependencytrack_teams" "all" {}
+
+# Map team names to their IDs
+output "team_ids" {
+  value = { for team in data.dependencytrack_teams.all.teams : team.name => team.id }
+}
+
+# Teams holding administrative permissions
+output "admin_teams" {
+  value = [
+    for team in data.dependencytrack_teams.all.teams : team.name
+    if contains(team.permissions, "ACCESS_MANAGEMENT")
+  ]
+}
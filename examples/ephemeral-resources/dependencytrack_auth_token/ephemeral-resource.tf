@@ -0,0 +1,11 @@
+variable "dependencytrack_password" {
+  type      = string
+  sensitive = true
+}
+
+# Obtain a bearer token for calling the Dependency-Track API directly. The
+# token is never written to the state file.
+ephemeral "dependencytrack_auth_token" "admin" {
+  username = "admin"
+  password = var.dependencytrack_password
+}
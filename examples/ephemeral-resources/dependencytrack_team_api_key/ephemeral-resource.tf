@@ -0,0 +1,6 @@
+# Generate a temporary API key for a CI team. The key is deleted again once
+# Terraform is done with it and is never written to the state file.
+ephemeral "dependencytrack_team_api_key" "ci" {
+  team    = dependencytrack_team.ci.id
+  comment = "Temporary key for CI"
+}
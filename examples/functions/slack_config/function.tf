@@ -0,0 +1,17 @@
+variable "slack_webhook_url" {
+  type      = string
+  sensitive = true
+}
+
+data "dependencytrack_notification_publisher" "slack" {
+  name = "Slack"
+}
+
+resource "dependencytrack_notification_rule" "slack" {
+  name               = "New vulnerabilities to Slack"
+  scope              = "PORTFOLIO"
+  notification_level = "INFORMATIONAL"
+  notify_on          = ["NEW_VULNERABILITY"]
+  publisher          = data.dependencytrack_notification_publisher.slack.id
+  publisher_config   = provider::dependencytrack::slack_config(var.slack_webhook_url)
+}
@@ -0,0 +1,12 @@
+data "dependencytrack_notification_publisher" "webhook" {
+  name = "Outbound Webhook"
+}
+
+resource "dependencytrack_notification_rule" "webhook" {
+  name               = "Critical vulnerabilities to webhook"
+  scope              = "PORTFOLIO"
+  notification_level = "INFORMATIONAL"
+  notify_on          = ["NEW_VULNERABILITY"]
+  publisher          = data.dependencytrack_notification_publisher.webhook.id
+  publisher_config   = provider::dependencytrack::webhook_config("https://hooks.example.com/dependency-track")
+}
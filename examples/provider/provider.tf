@@ -10,3 +10,25 @@ provider "dependencytrack" {
   username = "admin"
   password = "admin123"
 }
+
+# Tag every project created through this provider configuration
+provider "dependencytrack" {
+  endpoint     = "https://dtrack.example.com"
+  api_key      = "your-api-key-here"
+  default_tags = ["managed-by-terraform"]
+}
+
+# Organization-wide defaults for projects that don't set active or classifier
+provider "dependencytrack" {
+  endpoint                   = "https://dtrack.example.com"
+  api_key                    = "your-api-key-here"
+  default_project_active     = true
+  default_project_classifier = "APPLICATION"
+}
+
+# Limit the number of requests sent to the server at the same time
+provider "dependencytrack" {
+  endpoint                = "https://dtrack.example.com"
+  api_key                 = "your-api-key-here"
+  max_concurrent_requests = 4
+}
@@ -0,0 +1,2 @@
+# The ACL configuration is a singleton and is imported using its config property ID
+terraform import dependencytrack_acl_config.this access-management/acl.enabled
@@ -0,0 +1,11 @@
+# Enable portfolio access control so that ACL mappings take effect
+resource "dependencytrack_acl_config" "this" {
+  enabled = true
+}
+
+resource "dependencytrack_acl_mapping" "example" {
+  team    = dependencytrack_team.example.id
+  project = dependencytrack_project.example.id
+
+  depends_on = [dependencytrack_acl_config.this]
+}
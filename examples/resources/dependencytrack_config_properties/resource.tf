@@ -0,0 +1,17 @@
+# Bootstrap the email settings of a fresh instance in one place
+resource "dependencytrack_config_properties" "email" {
+  properties = {
+    "email/smtp.enabled"         = "true"
+    "email/smtp.from.address"    = "dependency-track@example.com"
+    "email/smtp.server.hostname" = "smtp.example.com"
+    "email/smtp.server.port"     = "587"
+    "email/smtp.ssltls"          = "true"
+    "email/smtp.username"        = "dependency-track"
+    "email/smtp.password"        = var.smtp_password
+  }
+}
+
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# There is a single Jira configuration; any ID adopts it
+terraform import dependencytrack_jira_config.this jira_config
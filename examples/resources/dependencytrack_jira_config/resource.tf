@@ -0,0 +1,10 @@
+resource "dependencytrack_jira_config" "this" {
+  url      = "https://example.atlassian.net"
+  username = "dependency-track@example.com"
+  password = var.jira_api_token
+}
+
+variable "jira_api_token" {
+  type      = string
+  sensitive = true
+}
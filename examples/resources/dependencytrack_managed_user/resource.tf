@@ -7,4 +7,32 @@ resource "dependencytrack_managed_user" "example" {
   suspended             = false
   force_password_change = false
   non_expiry_password   = false
-}
\ No newline at end of file
+}
+# Keep the password out of the Terraform state (Terraform 1.11+). Bump
+# password_wo_version whenever the password should be sent again.
+variable "ci_user_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "dependencytrack_managed_user" "ci" {
+  username = "ci"
+  fullname = "CI User"
+
+  password_wo         = var.ci_user_password
+  password_wo_version = 1
+}
+
+# Manage the user's team memberships as a set. Teams added outside of
+# Terraform are removed on the next apply.
+resource "dependencytrack_team" "auditors" {
+  name = "Auditors"
+}
+
+resource "dependencytrack_managed_user" "auditor" {
+  username = "auditor"
+  fullname = "Auditor"
+  password = "SecureP@ssw0rd123"
+
+  teams = [dependencytrack_team.auditors.id]
+}
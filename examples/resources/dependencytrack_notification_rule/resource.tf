@@ -55,3 +55,27 @@ resource "dependencytrack_notification_rule" "system_alerts" {
     "DATASOURCE_MIRRORING"
   ]
 }
+
+# Reference a built-in publisher by class instead of UUID
+resource "dependencytrack_notification_rule" "builtin_slack" {
+  name            = "Slack Vulnerability Alerts"
+  scope           = "PORTFOLIO"
+  publisher_class = "org.dependencytrack.notification.publisher.SlackPublisher"
+
+  notify_on = [
+    "NEW_VULNERABILITY"
+  ]
+}
+
+# Weekday morning digest of new vulnerabilities (Dependency-Track 4.13+)
+resource "dependencytrack_notification_rule" "daily_digest" {
+  name          = "Daily Vulnerability Digest"
+  scope         = "PORTFOLIO"
+  publisher     = dependencytrack_notification_publisher.slack.id
+  trigger_type  = "SCHEDULE"
+  schedule_cron = "0 8 * * MON-FRI"
+
+  notify_on = [
+    "NEW_VULNERABILITIES_SUMMARY"
+  ]
+}
@@ -5,4 +5,64 @@ resource "dependencytrack_project" "example" {
   group       = "com.example"
   classifier  = "APPLICATION"
   active      = true
-}
\ No newline at end of file
+
+  # Refuse to destroy the project (and its findings and history) until this
+  # is set back to false
+  deletion_protection = true
+}
+
+# Mark a release as the latest version of the project. Setting this on a new
+# version clears it on the previous one; leave it unset on older versions.
+resource "dependencytrack_project" "release" {
+  name      = "My Application"
+  version   = "2.0.0"
+  is_latest = true
+}
+
+# Give the owning team access to the project as soon as it is created, for
+# setups with portfolio access control enabled
+resource "dependencytrack_team" "payments" {
+  name = "Payments"
+}
+
+resource "dependencytrack_project" "payments_api" {
+  name               = "Payments API"
+  grant_access_teams = [dependencytrack_team.payments.id]
+}
+
+# Record the supplier and manufacturer reported in the project's CycloneDX
+# metadata, e.g. for SBOM attestations
+resource "dependencytrack_project" "firmware" {
+  name       = "Controller Firmware"
+  version    = "3.2.0"
+  classifier = "FIRMWARE"
+
+  supplier = {
+    name = "Acme Supply"
+    url  = "https://supply.example.com"
+    contacts = [
+      { name = "Jane Doe", email = "jane@example.com", phone = "+1 555 0100" },
+      { email = "support@example.com" },
+    ]
+  }
+
+  manufacturer = {
+    name = "Acme Manufacturing"
+    url  = "https://manufacturing.example.com"
+  }
+}
+
+# List the authors and link the repository and website of the project
+resource "dependencytrack_project" "portal" {
+  name = "Customer Portal"
+
+  authors = [
+    { name = "Jane Doe", email = "jane@example.com" },
+    { name = "John Doe" },
+  ]
+
+  external_references = [
+    { type = "vcs", url = "https://git.example.com/portal.git" },
+    { type = "website", url = "https://portal.example.com", comment = "Production" },
+  ]
+}
@@ -0,0 +1,3 @@
+# Project analyses can be imported using the project UUID. All findings of the
+# project that are analyzed or suppressed are adopted.
+terraform import dependencytrack_project_analyses.example 00000000-0000-0000-0000-000000000000
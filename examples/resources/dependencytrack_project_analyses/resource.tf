@@ -0,0 +1,53 @@
+resource "dependencytrack_project_analyses" "example" {
+  project = "00000000-0000-0000-0000-000000000000"
+
+  analyses = [
+    {
+      component     = "11111111-1111-1111-1111-111111111111"
+      vulnerability = "22222222-2222-2222-2222-222222222222"
+      state         = "NOT_AFFECTED"
+      justification = "CODE_NOT_REACHABLE"
+      details       = "The vulnerable function is never called"
+      suppressed    = true
+    },
+    {
+      component     = "11111111-1111-1111-1111-111111111111"
+      vulnerability = "33333333-3333-3333-3333-333333333333"
+      state         = "EXPLOITABLE"
+      response      = "UPDATE"
+    },
+  ]
+}
+
+# Carry the suppressions of one project over to another version of it,
+# matching findings by component name and vulnerability ID
+data "dependencytrack_project_findings" "old" {
+  project    = "44444444-4444-4444-4444-444444444444"
+  suppressed = true
+}
+
+data "dependencytrack_project_findings" "new" {
+  project    = "55555555-5555-5555-5555-555555555555"
+  suppressed = true
+}
+
+locals {
+  suppressed = toset([
+    for f in data.dependencytrack_project_findings.old.findings : "${f.component_name}/${f.vuln_id}"
+    if f.is_suppressed
+  ])
+}
+
+resource "dependencytrack_project_analyses" "migrated" {
+  project = data.dependencytrack_project_findings.new.project
+
+  analyses = [
+    for f in data.dependencytrack_project_findings.new.findings : {
+      component     = f.component_uuid
+      vulnerability = f.vulnerability_uuid
+      state         = "NOT_AFFECTED"
+      suppressed    = true
+    }
+    if contains(local.suppressed, "${f.component_name}/${f.vuln_id}")
+  ]
+}
@@ -0,0 +1,2 @@
+# Project tag assignments can be imported using the format: tag_name/project_uuid
+terraform import dependencytrack_project_tag.example production/00000000-0000-0000-0000-000000000001
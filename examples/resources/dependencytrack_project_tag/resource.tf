@@ -0,0 +1,14 @@
+resource "dependencytrack_tag" "production" {
+  name = "production"
+}
+
+resource "dependencytrack_project" "api" {
+  name    = "API Service"
+  version = "1.0.0"
+}
+
+# Tag the project without touching tags added by other modules
+resource "dependencytrack_project_tag" "api_production" {
+  tag     = dependencytrack_tag.production.name
+  project = dependencytrack_project.api.id
+}
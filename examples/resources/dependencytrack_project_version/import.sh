@@ -0,0 +1,2 @@
+# Project versions can be imported using their UUID
+terraform import dependencytrack_project_version.example 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,19 @@
+variable "release" {
+  type    = string
+  default = "2.4.0"
+}
+
+resource "dependencytrack_project" "webshop" {
+  name             = "Webshop"
+  collection_logic = "AGGREGATE_LATEST_VERSION_CHILDREN"
+}
+
+# Bumping var.release creates the new version under the same parent, moves
+# the latest flag to it and deactivates the version it supersedes. Superseded
+# versions are kept in Dependency-Track.
+resource "dependencytrack_project_version" "webshop_backend" {
+  name                = "Webshop Backend"
+  version             = var.release
+  parent_uuid         = dependencytrack_project.webshop.id
+  deactivate_previous = true
+}
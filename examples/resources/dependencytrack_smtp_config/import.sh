@@ -0,0 +1,2 @@
+# There is a single SMTP configuration; any ID adopts it
+terraform import dependencytrack_smtp_config.this smtp_config
@@ -0,0 +1,13 @@
+resource "dependencytrack_smtp_config" "this" {
+  server       = "smtp.example.com"
+  port         = 587
+  use_tls      = true
+  from_address = "dependency-track@example.com"
+  username     = "dependency-track"
+  password     = var.smtp_password
+}
+
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+}
@@ -1,3 +1,10 @@
 resource "dependencytrack_tag" "example" {
   name = "production"
 }
+
+# Detach the tag from every project, policy and notification rule when it is
+# destroyed, instead of failing while it is still in use.
+resource "dependencytrack_tag" "release" {
+  name          = "release"
+  force_destroy = true
+}
@@ -1,3 +1,28 @@
 resource "dependencytrack_team" "example" {
   name = "Security Team"
-}
\ No newline at end of file
+}
+
+# A team with its permissions and group mappings described in one block
+resource "dependencytrack_oidc_group" "developers" {
+  name = "developers"
+}
+
+resource "dependencytrack_team" "developers" {
+  name = "Developers"
+
+  permissions = [
+    "BOM_UPLOAD",
+    "VIEW_PORTFOLIO",
+    "VIEW_VULNERABILITY",
+  ]
+
+  oidc_groups = [dependencytrack_oidc_group.developers.id]
+  ldap_dns    = ["cn=developers,ou=groups,dc=example,dc=com"]
+}
+
+# A CI team whose API keys and project access are handed out outside
+# Terraform; force_destroy removes them when the team is destroyed
+resource "dependencytrack_team" "ci" {
+  name          = "CI"
+  force_destroy = true
+}
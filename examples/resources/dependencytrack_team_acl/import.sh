@@ -0,0 +1,2 @@
+# A team's ACL can be imported using the team UUID
+terraform import dependencytrack_team_acl.example 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,23 @@
+resource "dependencytrack_team" "frontend" {
+  name = "Frontend"
+}
+
+resource "dependencytrack_project" "web" {
+  name    = "web"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project" "mobile" {
+  name    = "mobile"
+  version = "1.0.0"
+}
+
+# Grant the team access to exactly these projects; any other project mapped
+# to the team is removed.
+resource "dependencytrack_team_acl" "frontend" {
+  team = dependencytrack_team.frontend.id
+  projects = [
+    dependencytrack_project.web.id,
+    dependencytrack_project.mobile.id,
+  ]
+}
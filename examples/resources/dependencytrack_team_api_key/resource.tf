@@ -11,4 +11,10 @@ resource "dependencytrack_team_api_key" "ci_cd" {
 output "api_key" {
   value     = dependencytrack_team_api_key.ci_cd.key
   sensitive = true
-}
\ No newline at end of file
+}
+# Changing rotation_token regenerates the key in place, keeping its comment
+resource "dependencytrack_team_api_key" "rotated" {
+  team           = dependencytrack_team.automation.id
+  comment        = "Rotated quarterly"
+  rotation_token = "2026-Q4"
+}
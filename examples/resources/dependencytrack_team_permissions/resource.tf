@@ -10,4 +10,14 @@ resource "dependencytrack_team_permissions" "example" {
     "VIEW_VULNERABILITY",
     "VULNERABILITY_ANALYSIS"
   ]
-}
\ No newline at end of file
+}
+
+# Pin the permissions of the Automation team created by Dependency-Track
+data "dependencytrack_team" "automation" {
+  name = "Automation"
+}
+
+resource "dependencytrack_team_permissions" "automation" {
+  team        = data.dependencytrack_team.automation.id
+  permissions = ["BOM_UPLOAD", "PROJECT_CREATION_UPLOAD", "VIEW_PORTFOLIO"]
+}
@@ -1,2 +1,5 @@
 # User team memberships can be imported using the format: username/team_uuid
-terraform import dependencytrack_user_team_membership.example john.doe/00000000-0000-0000-0000-000000000001
\ No newline at end of file
+terraform import dependencytrack_user_team_membership.example john.doe/00000000-0000-0000-0000-000000000001
+
+# Append the user type (managed, ldap or oidc) when the configuration sets user_type
+terraform import dependencytrack_user_team_membership.example john.doe/00000000-0000-0000-0000-000000000001/managed
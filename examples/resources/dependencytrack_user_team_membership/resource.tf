@@ -12,4 +12,10 @@ resource "dependencytrack_managed_user" "john_doe" {
 resource "dependencytrack_user_team_membership" "john_developers" {
   username = dependencytrack_managed_user.john_doe.username
   team     = dependencytrack_team.developers.id
-}
\ No newline at end of file
+}
+# Setting user_type limits membership checks to the matching user list
+resource "dependencytrack_user_team_membership" "john_developers_managed" {
+  username  = dependencytrack_managed_user.john_doe.username
+  team      = dependencytrack_team.developers.id
+  user_type = "managed"
+}
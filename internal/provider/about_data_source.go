@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AboutDataSource{}
+
+func NewAboutDataSource() datasource.DataSource {
+	return &AboutDataSource{}
+}
+
+// AboutDataSource defines the data source implementation.
+type AboutDataSource struct {
+	data *Data
+}
+
+// AboutDataSourceModel describes the data source data model.
+type AboutDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Application      types.String `tfsdk:"application"`
+	Version          types.String `tfsdk:"version"`
+	MajorVersion     types.Int64  `tfsdk:"major_version"`
+	MinorVersion     types.Int64  `tfsdk:"minor_version"`
+	Timestamp        types.String `tfsdk:"timestamp"`
+	UUID             types.String `tfsdk:"uuid"`
+	SystemUUID       types.String `tfsdk:"system_uuid"`
+	FrameworkVersion types.String `tfsdk:"framework_version"`
+}
+
+func (d *AboutDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_about"
+}
+
+func (d *AboutDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves version information about the Dependency-Track server, as reported by `GET /api/version`. " +
+			"Useful for asserting in a precondition that the server is new enough for a given feature.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the data source (same as `uuid`)",
+			},
+			"application": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The application name (e.g., `Dependency-Track`)",
+			},
+			"version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The full server version string (e.g., `4.13.2` or `5.0.0-SNAPSHOT`)",
+			},
+			"major_version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The major component of the server version",
+			},
+			"minor_version": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The minor component of the server version",
+			},
+			"timestamp": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The build timestamp of the server",
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the application build",
+			},
+			"system_uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID identifying this Dependency-Track installation",
+			},
+			"framework_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The version of the framework the server is built on (Alpine on Dependency-Track v4)",
+			},
+		},
+	}
+}
+
+func (d *AboutDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *AboutDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AboutDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	about, err := d.data.Client.About.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server version, got error: %s", err))
+		return
+	}
+
+	// The provider already parsed the version while configuring, but read it
+	// again so the numeric fields match the version string returned here.
+	serverVersion, err := parseServerVersion(about.Version)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Server Version", fmt.Sprintf("Unable to parse server version %q: %s", about.Version, err))
+		return
+	}
+
+	data.ID = types.StringValue(about.UUID.String())
+	data.Application = types.StringValue(about.Application)
+	data.Version = types.StringValue(about.Version)
+	data.MajorVersion = types.Int64Value(int64(serverVersion.Major))
+	data.MinorVersion = types.Int64Value(int64(serverVersion.Minor))
+	data.Timestamp = types.StringValue(about.Timestamp)
+	data.UUID = types.StringValue(about.UUID.String())
+	data.SystemUUID = types.StringValue(about.SystemUUID.String())
+	data.FrameworkVersion = types.StringValue(about.Framework.Version)
+
+	tflog.Trace(ctx, "read the about data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccAboutDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+data "dependencytrack_about" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_about.test",
+						tfjsonpath.New("application"),
+						knownvalue.StringExact("Dependency-Track"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_about.test",
+						tfjsonpath.New("version"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^\d+\.\d+`)),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_about.test",
+						tfjsonpath.New("major_version"),
+						knownvalue.Int64Func(func(v int64) error {
+							if v < 4 {
+								return fmt.Errorf("expected major version 4 or newer, got %d", v)
+							}
+							return nil
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_about.test",
+						tfjsonpath.New("uuid"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
@@ -15,8 +15,9 @@ import (
 
 // This file contains helpers that seed the Dependency-Track instance under
 // test with data that cannot be created through the provider itself
-// (components, vulnerabilities, BOM uploads), so read-only data sources such
-// as dependencytrack_project_findings and dependencytrack_project_violations
+// (components, services, vulnerabilities, BOM uploads), so read-only data
+// sources such as dependencytrack_project_findings,
+// dependencytrack_project_violations and dependencytrack_project_components
 // have something real to return.
 
 // testAccAPIDo performs an authenticated JSON request against the
@@ -101,6 +102,71 @@ func testAccSeedProject(t *testing.T, name, version string) string {
 	return project.UUID
 }
 
+// testAccSeedTeam creates a team named name through the API and registers its
+// deletion as test cleanup. It returns the team UUID.
+func testAccSeedTeam(t *testing.T, name string) string {
+	t.Helper()
+
+	var team struct {
+		UUID string `json:"uuid"`
+	}
+	status := testAccAPIDo(t, http.MethodPut, "/api/v1/team", map[string]string{
+		"name": name,
+	}, &team)
+	if status < 200 || status >= 300 {
+		t.Fatalf("creating seed team %q: unexpected status %d", name, status)
+	}
+	t.Cleanup(func() {
+		testAccAPIDo(t, http.MethodDelete, "/api/v1/team", map[string]string{"uuid": team.UUID}, nil)
+	})
+
+	return team.UUID
+}
+
+// testAccSeedProjectWithComponent creates a project containing a single
+// component with a group and package URL set. It returns the project UUID.
+func testAccSeedProjectWithComponent(t *testing.T) string {
+	t.Helper()
+	testAccSeedPreCheck(t)
+
+	projectUUID := testAccSeedProject(t, "tf-acc-components-"+randomSuffix(), "1.0.0")
+
+	status := testAccAPIDo(t, http.MethodPut, "/api/v1/component/project/"+projectUUID, map[string]string{
+		"group":      "org.example",
+		"name":       "tf-acc-component",
+		"version":    "2.0.1",
+		"purl":       "pkg:maven/org.example/tf-acc-component@2.0.1",
+		"classifier": "LIBRARY",
+	}, nil)
+	if status < 200 || status >= 300 {
+		t.Fatalf("creating seed component: unexpected status %d", status)
+	}
+
+	return projectUUID
+}
+
+// testAccSeedProjectWithService creates a project containing a single service
+// with a provider and two endpoints. It returns the project UUID.
+func testAccSeedProjectWithService(t *testing.T) string {
+	t.Helper()
+	testAccSeedPreCheck(t)
+
+	projectUUID := testAccSeedProject(t, "tf-acc-services-"+randomSuffix(), "1.0.0")
+
+	status := testAccAPIDo(t, http.MethodPut, "/api/v1/service/project/"+projectUUID, map[string]any{
+		"group":     "org.example",
+		"name":      "tf-acc-service",
+		"version":   "1.2.0",
+		"provider":  map[string]string{"name": "Example Corp"},
+		"endpoints": []string{"https://api.example.com/v1", "https://api.example.com/v2"},
+	}, nil)
+	if status < 200 || status >= 300 {
+		t.Fatalf("creating seed service: unexpected status %d", status)
+	}
+
+	return projectUUID
+}
+
 // testAccSeedProjectWithFinding creates a project containing one component
 // with an internal vulnerability (CWE-79 and CWE-89) assigned to it, so the
 // project has exactly one unsuppressed finding. It returns the project UUID.
@@ -209,3 +275,35 @@ func testAccSeedProjectWithViolation(t *testing.T) string {
 		time.Sleep(3 * time.Second)
 	}
 }
+
+// testAccSuppressProjectFindings marks every finding of the project as a
+// suppressed false positive via the analysis API.
+func testAccSuppressProjectFindings(t *testing.T, projectUUID string) {
+	t.Helper()
+
+	var findings []struct {
+		Component struct {
+			UUID string `json:"uuid"`
+		} `json:"component"`
+		Vulnerability struct {
+			UUID string `json:"uuid"`
+		} `json:"vulnerability"`
+	}
+	status := testAccAPIDo(t, http.MethodGet, "/api/v1/finding/project/"+projectUUID, nil, &findings)
+	if status != http.StatusOK {
+		t.Fatalf("listing findings of seed project %s: unexpected status %d", projectUUID, status)
+	}
+
+	for _, f := range findings {
+		status = testAccAPIDo(t, http.MethodPut, "/api/v1/analysis", map[string]any{
+			"project":       projectUUID,
+			"component":     f.Component.UUID,
+			"vulnerability": f.Vulnerability.UUID,
+			"analysisState": "FALSE_POSITIVE",
+			"isSuppressed":  true,
+		}, nil)
+		if status < 200 || status >= 300 {
+			t.Fatalf("suppressing seed finding: unexpected status %d", status)
+		}
+	}
+}
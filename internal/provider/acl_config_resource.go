@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// The config property that gates portfolio access control.
+const (
+	aclConfigGroupName    = "access-management"
+	aclConfigPropertyName = "acl.enabled"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ACLConfigResource{}
+var _ resource.ResourceWithImportState = &ACLConfigResource{}
+
+func NewACLConfigResource() resource.Resource {
+	return &ACLConfigResource{}
+}
+
+// ACLConfigResource defines the resource implementation.
+type ACLConfigResource struct {
+	data *Data
+}
+
+// ACLConfigResourceModel describes the resource data model.
+type ACLConfigResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *ACLConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_config"
+}
+
+func (r *ACLConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages whether portfolio access control is enabled in Dependency-Track. " +
+			"This is a typed convenience wrapper around the `access-management`/`acl.enabled` configuration property; " +
+			"`dependencytrack_acl_mapping` has no effect while access control is disabled. " +
+			"When destroyed, the setting is only removed from Terraform state and keeps its current value. " +
+			"Do not manage the same property with `dependencytrack_config_property` as well.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the underlying config property (always `access-management/acl.enabled`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Required:            true,
+				MarkdownDescription: "Whether portfolio access control is enabled",
+			},
+		},
+	}
+}
+
+func (r *ACLConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *ACLConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ACLConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The property always exists, so creating the resource adopts it.
+	enabled, err := r.setEnabled(ctx, data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ACL configuration, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(aclConfigGroupName + "/" + aclConfigPropertyName)
+	data.Enabled = types.BoolValue(enabled)
+
+	tflog.Trace(ctx, "created an ACL config resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ACLConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prop, err := r.data.Client.Config.Get(ctx, aclConfigGroupName, aclConfigPropertyName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACL configuration, got error: %s", err))
+		return
+	}
+
+	enabled, err := parseACLEnabled(prop.Value)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Config Value", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(aclConfigGroupName + "/" + aclConfigPropertyName)
+	data.Enabled = types.BoolValue(enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ACLConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	enabled, err := r.setEnabled(ctx, data.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ACL configuration, got error: %s", err))
+		return
+	}
+
+	data.Enabled = types.BoolValue(enabled)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACLConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The config property cannot be deleted. Simply remove from Terraform
+	// state; the setting keeps its current value in Dependency-Track.
+}
+
+func (r *ACLConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	expected := aclConfigGroupName + "/" + aclConfigPropertyName
+	if req.ID != expected {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The ACL configuration is a singleton and must be imported with the ID %q, got: %s", expected, req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// setEnabled writes the acl.enabled property and returns the value the server
+// reports back.
+func (r *ACLConfigResource) setEnabled(ctx context.Context, enabled bool) (bool, error) {
+	prop, err := r.data.Client.Config.Update(ctx, dtrack.ConfigProperty{
+		GroupName: aclConfigGroupName,
+		Name:      aclConfigPropertyName,
+		Type:      "BOOLEAN",
+		Value:     strconv.FormatBool(enabled),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return parseACLEnabled(prop.Value)
+}
+
+// parseACLEnabled converts the BOOLEAN property value to a bool. An empty
+// value means the property was never set, which Dependency-Track treats as
+// disabled.
+func parseACLEnabled(value string) (bool, error) {
+	if value == "" {
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("config property %s/%s has non-boolean value %q", aclConfigGroupName, aclConfigPropertyName, value)
+	}
+
+	return enabled, nil
+}
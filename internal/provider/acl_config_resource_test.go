@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccACLConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccACLConfigResourceConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_acl_config.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("access-management/acl.enabled"),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_acl_config.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dependencytrack_acl_config.test",
+				ImportState:       true,
+				ImportStateId:     "access-management/acl.enabled",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccACLConfigResourceConfig(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_acl_config.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Import with a wrong ID is rejected
+			{
+				ResourceName:  "dependencytrack_acl_config.test",
+				ImportState:   true,
+				ImportStateId: "general/base.url",
+				ExpectError:   regexp.MustCompile(`Invalid Import ID`),
+			},
+		},
+	})
+}
+
+func testAccACLConfigResourceConfig(enabled bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_acl_config" "test" {
+  enabled = %t
+}
+`, enabled)
+}
+
+func TestParseACLEnabled(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{value: "true", want: true},
+		{value: "false", want: false},
+		{value: "", want: false},
+		{value: "yes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseACLEnabled(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseACLEnabled(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseACLEnabled(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
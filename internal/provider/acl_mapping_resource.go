@@ -2,9 +2,7 @@ package provider
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
@@ -42,7 +40,7 @@ func (r *ACLMappingResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *ACLMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages an ACL mapping between a team and a project in Dependency-Track. ACL mappings control which teams have access to which projects when portfolio access control is enabled.",
+		MarkdownDescription: "Manages an ACL mapping between a team and a project in Dependency-Track. ACL mappings control which teams have access to which projects when portfolio access control is enabled (see `dependencytrack_acl_config`). To manage all of a team's ACL mappings in one resource, use `dependencytrack_team_acl` instead; do not combine the two for the same team.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -114,7 +112,9 @@ func (r *ACLMappingResource) Create(ctx context.Context, req resource.CreateRequ
 		Project: projectUUID,
 	}
 
-	err = r.data.Client.ACL.AddProjectMapping(ctx, mapping)
+	// Mappings added in parallel can collide on the team and fail with a
+	// transient 409; see withConflictRetry.
+	err = r.data.Client.ACL.AddProjectMapping(withConflictRetry(ctx), mapping)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ACL mapping, got error: %s", err))
 		return
@@ -146,22 +146,22 @@ func (r *ACLMappingResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Use ForEach to check if the project is in the team's ACL with automatic pagination
-	found := false
-	errProjectFound := errors.New("project found") // Sentinel error to break out of ForEach
-
-	err = dtrack.ForEach(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+	// Dependency-Track has no endpoint to check a single team/project ACL
+	// mapping, and GET /api/v1/acl/team/{uuid} accepts no project filter, so
+	// the team's project list is the only source of truth. findInPages
+	// requests it in pages of 100 and stops at the page containing the
+	// project, so a mapping is typically confirmed without listing the
+	// team's whole ACL.
+	_, found, err := findInPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
 		return r.data.Client.ACL.GetAllProjects(ctx, teamUUID, po)
-	}, func(project dtrack.Project) error {
-		if project.UUID == projectUUID {
-			found = true
-			return errProjectFound // Return sentinel error to stop iteration
-		}
-		return nil
+	}, func(project dtrack.Project) bool {
+		return project.UUID == projectUUID
 	})
-
-	// Check if iteration was stopped because project was found
-	if err != nil && !errors.Is(err, errProjectFound) {
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACL mappings, got error: %s", err))
 		return
 	}
@@ -206,7 +206,7 @@ func (r *ACLMappingResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err = r.data.Client.ACL.RemoveProjectMapping(ctx, teamUUID, projectUUID)
+	err = r.data.Client.ACL.RemoveProjectMapping(withConflictRetry(ctx), teamUUID, projectUUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ACL mapping, got error: %s", err))
 		return
@@ -215,30 +215,9 @@ func (r *ACLMappingResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 func (r *ACLMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import using format: team_uuid/project_uuid
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected format: team_uuid/project_uuid, got: %s", req.ID),
-		)
-		return
-	}
-
-	teamUUID, err := uuid.Parse(parts[0])
+	teamUUID, projectUUID, err := parseCompositeUUIDID(req.ID, "team_uuid", "project_uuid")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Team UUID",
-			fmt.Sprintf("Unable to parse team UUID from import ID: %s\nError: %s", parts[0], err),
-		)
-		return
-	}
-
-	projectUUID, err := uuid.Parse(parts[1])
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project UUID",
-			fmt.Sprintf("Unable to parse project UUID from import ID: %s\nError: %s", parts[1], err),
-		)
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Unable to parse import ID: %s\nExpected format: team_uuid/project_uuid", err))
 		return
 	}
 
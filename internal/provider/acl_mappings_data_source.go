@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ACLMappingsDataSource{}
+
+func NewACLMappingsDataSource() datasource.DataSource {
+	return &ACLMappingsDataSource{}
+}
+
+// ACLMappingsDataSource defines the data source implementation.
+type ACLMappingsDataSource struct {
+	data *Data
+}
+
+// ACLMappingsDataSourceModel describes the data source data model.
+type ACLMappingsDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Team     types.String `tfsdk:"team"`
+	Projects types.Set    `tfsdk:"projects"`
+}
+
+func (d *ACLMappingsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acl_mappings"
+}
+
+func (d *ACLMappingsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the UUIDs of every project a Dependency-Track team has been granted access to through ACL mappings. " +
+			"Useful for detecting drift between declared `dependencytrack_acl_mapping` resources and the mappings that actually exist on the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the team",
+			},
+			"team": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the team to retrieve ACL mappings for",
+			},
+			"projects": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The UUIDs of all projects mapped to the team",
+			},
+		},
+	}
+}
+
+func (d *ACLMappingsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *ACLMappingsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ACLMappingsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamUUID, err := uuid.Parse(data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+		return
+	}
+
+	projects, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+		return d.data.Client.ACL.GetAllProjects(ctx, teamUUID, po)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACL mappings, got error: %s", err))
+		return
+	}
+
+	projectUUIDs := make([]string, 0, len(projects))
+	for _, project := range projects {
+		projectUUIDs = append(projectUUIDs, project.UUID.String())
+	}
+
+	projectSet, diags := types.SetValueFrom(ctx, types.StringType, projectUUIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(teamUUID.String())
+	data.Projects = projectSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccACLMappingsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccACLMappingsDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs(
+						"data.dependencytrack_acl_mappings.test",
+						tfjsonpath.New("id"),
+						"dependencytrack_team.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_acl_mappings.test",
+						tfjsonpath.New("projects"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccACLMappingsDataSourceConfig() string {
+	return testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_team" "test" {
+  name = "Test ACL Mappings Data Team"
+}
+
+resource "dependencytrack_project" "test1" {
+  name    = "Test ACL Mappings Data Project 1"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project" "test2" {
+  name    = "Test ACL Mappings Data Project 2"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_acl_mapping" "test1" {
+  team    = dependencytrack_team.test.id
+  project = dependencytrack_project.test1.id
+}
+
+resource "dependencytrack_acl_mapping" "test2" {
+  team    = dependencytrack_team.test.id
+  project = dependencytrack_project.test2.id
+}
+
+data "dependencytrack_acl_mappings" "test" {
+  team = dependencytrack_team.test.id
+  depends_on = [
+    dependencytrack_acl_mapping.test1,
+    dependencytrack_acl_mapping.test2
+  ]
+}
+`
+}
+
+func TestAccACLMappingsDataSource_Empty(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing for a team without any mappings
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_team" "test" {
+  name = "Test ACL Mappings Data Team Empty"
+}
+
+data "dependencytrack_acl_mappings" "test" {
+  team = dependencytrack_team.test.id
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_acl_mappings.test",
+						tfjsonpath.New("projects"),
+						knownvalue.SetSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
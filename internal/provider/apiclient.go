@@ -14,6 +14,8 @@ import (
 	"time"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // apiErrorBodyLimit caps how much of a non-2xx response body is retained on
@@ -34,14 +36,16 @@ type apiClient struct {
 // newAPIClient builds an apiClient for the given endpoint and credentials.
 // Exactly one of apiKey/bearerToken is expected to be non-empty, mirroring
 // the provider's mutually-exclusive authentication modes; apiKey takes
-// precedence if both happen to be set.
-func newAPIClient(endpoint, apiKey, bearerToken string) *apiClient {
+// precedence if both happen to be set. A nil transport uses
+// http.DefaultTransport.
+func newAPIClient(endpoint, apiKey, bearerToken string, transport http.RoundTripper) *apiClient {
 	return &apiClient{
 		baseURL:     strings.TrimSuffix(endpoint, "/"),
 		apiKey:      apiKey,
 		bearerToken: bearerToken,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: transport,
 		},
 	}
 }
@@ -54,9 +58,72 @@ type apiError struct {
 }
 
 func (e *apiError) Error() string {
+	if pd, ok := parseProblemDetails(e.Body); ok {
+		return fmt.Sprintf("dependency-track api error: status %d: %s", e.StatusCode, pd.message())
+	}
 	return fmt.Sprintf("dependency-track api error: status %d: %s", e.StatusCode, e.Body)
 }
 
+// problemDetails is the JSON error envelope Dependency-Track returns from
+// many endpoints (RFC 9457 application/problem+json on /api/v2).
+type problemDetails struct {
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+// message renders the title and detail as a single line, omitting whichever
+// is empty.
+func (pd problemDetails) message() string {
+	switch {
+	case pd.Title != "" && pd.Detail != "":
+		return pd.Title + ": " + pd.Detail
+	case pd.Title != "":
+		return pd.Title
+	default:
+		return pd.Detail
+	}
+}
+
+// parseProblemDetails decodes body as a problemDetails envelope. It reports
+// false when body is not JSON or carries neither a title nor a detail, in
+// which case callers should fall back to the raw body.
+func parseProblemDetails(body string) (problemDetails, bool) {
+	var pd problemDetails
+	if err := json.Unmarshal([]byte(body), &pd); err != nil {
+		return problemDetails{}, false
+	}
+	if pd.Title == "" && pd.Detail == "" {
+		return problemDetails{}, false
+	}
+	return pd, true
+}
+
+// addAPIErrorDiagnostic adds a "Client Error" diagnostic for a failed action
+// (e.g. "create secret"). When err carries a JSON error envelope, the detail
+// shows the server's title and explanation instead of the raw response body;
+// any other error is reported as-is.
+func addAPIErrorDiagnostic(diags *diag.Diagnostics, action string, err error) {
+	var ae *apiError
+	if errors.As(err, &ae) {
+		if pd, ok := parseProblemDetails(ae.Body); ok {
+			detail := fmt.Sprintf("Unable to %s: Dependency-Track responded with HTTP %d", action, ae.StatusCode)
+			if pd.Title != "" {
+				detail += " (" + pd.Title + ")"
+			}
+			if pd.Detail != "" {
+				detail += ".\n\n" + pd.Detail
+			} else {
+				detail += "."
+			}
+			diags.AddError("Client Error", detail)
+			return
+		}
+	}
+
+	diags.AddError("Client Error", fmt.Sprintf("Unable to %s, got error: %s", action, err))
+}
+
 // isNotFound reports whether err represents an HTTP 404 response, whether it
 // originated from apiClient (as *apiError) or from client-go's typed methods
 // (as a dtrack.APIError, which client-go returns as *dtrack.APIError but
@@ -147,8 +214,23 @@ func (c *apiClient) doWithHeaders(ctx context.Context, method, path string, body
 		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
 	}
 
+	// Bodies are deliberately not logged: they can carry secrets (generated
+	// API keys, secret values), and the headers are redacted for the same
+	// reason.
+	tflog.Debug(ctx, "sending Dependency-Track API request", map[string]any{
+		"method":  method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+	})
+
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		tflog.Debug(ctx, "Dependency-Track API request failed", map[string]any{
+			"method": method,
+			"url":    req.URL.String(),
+			"error":  err.Error(),
+		})
 		return nil, fmt.Errorf("perform request: %w", err)
 	}
 	defer resp.Body.Close()
@@ -158,6 +240,14 @@ func (c *apiClient) doWithHeaders(ctx context.Context, method, path string, body
 		return resp.Header, fmt.Errorf("read response body: %w", err)
 	}
 
+	tflog.Debug(ctx, "received Dependency-Track API response", map[string]any{
+		"method":      method,
+		"url":         req.URL.String(),
+		"status":      resp.StatusCode,
+		"duration_ms": time.Since(start).Milliseconds(),
+		"body_bytes":  len(respBody),
+	})
+
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		return resp.Header, &apiError{StatusCode: resp.StatusCode, Body: truncateBody(respBody)}
 	}
@@ -171,6 +261,24 @@ func (c *apiClient) doWithHeaders(ctx context.Context, method, path string, body
 	return resp.Header, nil
 }
 
+// redactedHeaderValue replaces credential header values in log output.
+const redactedHeaderValue = "[REDACTED]"
+
+// redactHeaders flattens h into a loggable map, replacing the values of the
+// authentication headers so credentials never reach the Terraform log.
+func redactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for name, values := range h {
+		switch http.CanonicalHeaderKey(name) {
+		case "X-Api-Key", "Authorization":
+			out[name] = redactedHeaderValue
+		default:
+			out[name] = strings.Join(values, ", ")
+		}
+	}
+	return out
+}
+
 // truncateBody returns b as a string, capped to apiErrorBodyLimit bytes.
 func truncateBody(b []byte) string {
 	if len(b) <= apiErrorBodyLimit {
@@ -265,6 +373,35 @@ func fetchAllPages[T any](ctx context.Context, fetch func(context.Context, dtrac
 	return nil, fmt.Errorf("fetchAllPages: exceeded safety cap of %d pages", apiGetAllPagesSafetyCap)
 }
 
+// findInPages pages through a client-go paginated list method like
+// fetchAllPages, but returns as soon as an item satisfies match instead of
+// collecting everything. It is meant for existence checks against endpoints
+// that have no get-by-id or filter variant: the cost is proportional to the
+// position of the match rather than to the size of the whole collection.
+func findInPages[T any](ctx context.Context, fetch func(context.Context, dtrack.PageOptions) (dtrack.Page[T], error), match func(T) bool) (T, bool, error) {
+	const pageSize = 100
+
+	var zero T
+	for page := 1; page <= apiGetAllPagesSafetyCap; page++ {
+		p, err := fetch(ctx, dtrack.PageOptions{PageNumber: page, PageSize: pageSize})
+		if err != nil {
+			return zero, false, err
+		}
+
+		for _, item := range p.Items {
+			if match(item) {
+				return item, true, nil
+			}
+		}
+
+		if len(p.Items) < pageSize {
+			return zero, false, nil
+		}
+	}
+
+	return zero, false, fmt.Errorf("findInPages: exceeded safety cap of %d pages", apiGetAllPagesSafetyCap)
+}
+
 // cloneQueryValues returns a copy of v so callers can mutate the result
 // without affecting the caller's url.Values (or a shared base map across
 // pagination loop iterations).
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 type apiClientTestItem struct {
@@ -18,7 +19,7 @@ type apiClientTestItem struct {
 }
 
 func TestNewAPIClient(t *testing.T) {
-	c := newAPIClient("https://dtrack.example.com/", "key", "")
+	c := newAPIClient("https://dtrack.example.com/", "key", "", nil)
 
 	if c.baseURL != "https://dtrack.example.com" {
 		t.Errorf("newAPIClient baseURL = %q, want trailing slash trimmed", c.baseURL)
@@ -55,7 +56,7 @@ func TestAPIClientDo_Success(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := newAPIClient(srv.URL, "test-key", "")
+	c := newAPIClient(srv.URL, "test-key", "", nil)
 
 	var out apiClientTestItem
 	err := c.Do(context.Background(), http.MethodPost, "/api/v1/thing", map[string]any{"name": "widget"}, &out)
@@ -109,7 +110,7 @@ func TestAPIClientDo_AuthHeaderSelection(t *testing.T) {
 			}))
 			defer srv.Close()
 
-			c := newAPIClient(srv.URL, tt.apiKey, tt.bearerToken)
+			c := newAPIClient(srv.URL, tt.apiKey, tt.bearerToken, nil)
 			if err := c.Do(context.Background(), http.MethodGet, "/api/v1/thing", nil, nil); err != nil {
 				t.Fatalf("Do returned unexpected error: %s", err)
 			}
@@ -124,13 +125,39 @@ func TestAPIClientDo_AuthHeaderSelection(t *testing.T) {
 	}
 }
 
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("X-Api-Key", "odt_secret")
+	h.Set("Authorization", "Bearer eyJhbGciOi")
+	h.Set("Content-Type", "application/json")
+	h.Add("Accept", "application/json")
+	h.Add("Accept", "application/problem+json")
+
+	got := redactHeaders(h)
+
+	want := map[string]string{
+		"X-Api-Key":     redactedHeaderValue,
+		"Authorization": redactedHeaderValue,
+		"Content-Type":  "application/json",
+		"Accept":        "application/json, application/problem+json",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("redactHeaders returned %d headers, want %d: %v", len(got), len(want), got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("header %s = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
 func TestAPIClientDo_NoContentSkipsDecode(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusNoContent)
 	}))
 	defer srv.Close()
 
-	c := newAPIClient(srv.URL, "key", "")
+	c := newAPIClient(srv.URL, "key", "", nil)
 
 	var out apiClientTestItem
 	if err := c.Do(context.Background(), http.MethodDelete, "/api/v1/thing", nil, &out); err != nil {
@@ -158,7 +185,7 @@ func TestAPIClientDo_ErrorStatusCodes(t *testing.T) {
 			}))
 			defer srv.Close()
 
-			c := newAPIClient(srv.URL, "key", "")
+			c := newAPIClient(srv.URL, "key", "", nil)
 
 			err := c.Do(context.Background(), http.MethodGet, "/api/v1/thing", nil, nil)
 			if err == nil {
@@ -187,7 +214,7 @@ func TestAPIClientDo_ErrorBodyTruncated(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := newAPIClient(srv.URL, "key", "")
+	c := newAPIClient(srv.URL, "key", "", nil)
 
 	err := c.Do(context.Background(), http.MethodGet, "/api/v1/thing", nil, nil)
 	if err == nil {
@@ -204,6 +231,95 @@ func TestAPIClientDo_ErrorBodyTruncated(t *testing.T) {
 	}
 }
 
+func TestAPIErrorError_ProblemDetails(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "title and detail",
+			body: `{"status":400,"title":"Bad Request","detail":"The secret name is invalid"}`,
+			want: "dependency-track api error: status 400: Bad Request: The secret name is invalid",
+		},
+		{
+			name: "title only",
+			body: `{"status":409,"title":"Conflict"}`,
+			want: "dependency-track api error: status 400: Conflict",
+		},
+		{
+			name: "detail only",
+			body: `{"detail":"Secret already exists"}`,
+			want: "dependency-track api error: status 400: Secret already exists",
+		},
+		{
+			name: "plain text falls back to raw body",
+			body: "The project could not be found.",
+			want: "dependency-track api error: status 400: The project could not be found.",
+		},
+		{
+			name: "JSON without envelope fields falls back to raw body",
+			body: `{"message":"nope"}`,
+			want: `dependency-track api error: status 400: {"message":"nope"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := &apiError{StatusCode: http.StatusBadRequest, Body: tt.body}
+			if got := err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddAPIErrorDiagnostic(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantDetail string
+	}{
+		{
+			name:       "problem details",
+			err:        &apiError{StatusCode: http.StatusBadRequest, Body: `{"status":400,"title":"Bad Request","detail":"Name must not be blank"}`},
+			wantDetail: "Unable to create secret: Dependency-Track responded with HTTP 400 (Bad Request).\n\nName must not be blank",
+		},
+		{
+			name:       "problem details without detail",
+			err:        &apiError{StatusCode: http.StatusConflict, Body: `{"title":"Conflict"}`},
+			wantDetail: "Unable to create secret: Dependency-Track responded with HTTP 409 (Conflict).",
+		},
+		{
+			name:       "raw body",
+			err:        &apiError{StatusCode: http.StatusInternalServerError, Body: "boom"},
+			wantDetail: "Unable to create secret, got error: dependency-track api error: status 500: boom",
+		},
+		{
+			name:       "non-API error",
+			err:        errors.New("perform request: connection refused"),
+			wantDetail: "Unable to create secret, got error: perform request: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addAPIErrorDiagnostic(&diags, "create secret", tt.err)
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+			}
+			if got := diags[0].Summary(); got != "Client Error" {
+				t.Errorf("Summary() = %q, want %q", got, "Client Error")
+			}
+			if got := diags[0].Detail(); got != tt.wantDetail {
+				t.Errorf("Detail() = %q, want %q", got, tt.wantDetail)
+			}
+		})
+	}
+}
+
 func TestIsNotFound_IsForbidden_AgainstDtrackAPIError(t *testing.T) {
 	// client-go's checkResponseForError returns a *dtrack.APIError, but APIError's
 	// Error() method has a value receiver, so a bare dtrack.APIError value also
@@ -259,7 +375,7 @@ func TestApiGetAllPages_MultiPageWithTotalCount(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := newAPIClient(srv.URL, "key", "")
+	c := newAPIClient(srv.URL, "key", "", nil)
 
 	got, err := apiGetAllPages[apiClientTestItem](context.Background(), c, "/api/v1/thing", url.Values{"filter": []string{"active"}})
 	if err != nil {
@@ -307,7 +423,7 @@ func TestApiGetAllPages_ShortPageTerminatesWithoutHeader(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := newAPIClient(srv.URL, "key", "")
+	c := newAPIClient(srv.URL, "key", "", nil)
 
 	got, err := apiGetAllPages[apiClientTestItem](context.Background(), c, "/api/v1/thing", nil)
 	if err != nil {
@@ -394,6 +510,86 @@ func TestFetchAllPages_SafetyCap(t *testing.T) {
 	}
 }
 
+// fakeCountingPages returns a client-go style page fetcher over total items
+// (IDs 0..total-1) that counts how many pages were requested.
+func fakeCountingPages(total int, requests *int) func(context.Context, dtrack.PageOptions) (dtrack.Page[apiClientTestItem], error) {
+	return func(_ context.Context, po dtrack.PageOptions) (dtrack.Page[apiClientTestItem], error) {
+		*requests++
+
+		start := (po.PageNumber - 1) * po.PageSize
+		end := min(start+po.PageSize, total)
+
+		var items []apiClientTestItem
+		for i := start; i < end; i++ {
+			items = append(items, apiClientTestItem{ID: i})
+		}
+
+		return dtrack.Page[apiClientTestItem]{Items: items, TotalCount: total}, nil
+	}
+}
+
+func TestFindInPages_StopsAtMatchingPage(t *testing.T) {
+	var requests int
+	fetch := fakeCountingPages(5000, &requests)
+
+	got, found, err := findInPages(context.Background(), fetch, func(item apiClientTestItem) bool { return item.ID == 150 })
+	if err != nil {
+		t.Fatalf("findInPages returned unexpected error: %s", err)
+	}
+	if !found || got.ID != 150 {
+		t.Fatalf("findInPages = (%v, %v), want item 150 found", got, found)
+	}
+	if requests != 2 {
+		t.Errorf("findInPages made %d requests, want 2 (stop on the page holding the match)", requests)
+	}
+}
+
+func TestFindInPages_NotFound(t *testing.T) {
+	var requests int
+	fetch := fakeCountingPages(250, &requests)
+
+	_, found, err := findInPages(context.Background(), fetch, func(item apiClientTestItem) bool { return item.ID == -1 })
+	if err != nil {
+		t.Fatalf("findInPages returned unexpected error: %s", err)
+	}
+	if found {
+		t.Fatal("findInPages reported a match for a missing item")
+	}
+	if requests != 3 {
+		t.Errorf("findInPages made %d requests, want 3 (100 + 100 + short 50)", requests)
+	}
+}
+
+func TestFindInPages_PropagatesError(t *testing.T) {
+	wantErr := errors.New("boom")
+	fetch := func(_ context.Context, _ dtrack.PageOptions) (dtrack.Page[apiClientTestItem], error) {
+		return dtrack.Page[apiClientTestItem]{}, wantErr
+	}
+
+	_, _, err := findInPages(context.Background(), fetch, func(apiClientTestItem) bool { return true })
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("findInPages error = %v, want %v", err, wantErr)
+	}
+}
+
+// BenchmarkFindInPages measures an ACL-style existence check against a team
+// with 10,000 accessible projects, with the wanted project near the front of
+// the list. It reports the page requests per lookup alongside the usual
+// timings; compare with a full fetchAllPages walk (100 requests).
+func BenchmarkFindInPages(b *testing.B) {
+	var requests int
+	fetch := fakeCountingPages(10000, &requests)
+	match := func(item apiClientTestItem) bool { return item.ID == 42 }
+
+	for b.Loop() {
+		if _, _, err := findInPages(context.Background(), fetch, match); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ReportMetric(float64(requests)/float64(b.N), "requests/op")
+}
+
 func TestApiGetAllPages_SafetyCap(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		items := make([]apiClientTestItem, 100)
@@ -403,7 +599,7 @@ func TestApiGetAllPages_SafetyCap(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := newAPIClient(srv.URL, "key", "")
+	c := newAPIClient(srv.URL, "key", "", nil)
 
 	_, err := apiGetAllPages[apiClientTestItem](context.Background(), c, "/api/v1/thing", nil)
 	if err == nil {
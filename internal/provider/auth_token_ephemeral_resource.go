@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &AuthTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &AuthTokenEphemeralResource{}
+
+func NewAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &AuthTokenEphemeralResource{}
+}
+
+// AuthTokenEphemeralResource defines the ephemeral resource implementation.
+type AuthTokenEphemeralResource struct {
+	data *Data
+}
+
+// AuthTokenEphemeralResourceModel describes the ephemeral resource data model.
+type AuthTokenEphemeralResourceModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Token    types.String `tfsdk:"token"`
+}
+
+func (r *AuthTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_token"
+}
+
+func (r *AuthTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Logs in to Dependency-Track with a username and password and returns a short-lived bearer token, without storing it in the Terraform state or plan. " +
+			"The login is performed against the provider's configured endpoint. Dependency-Track has no way to revoke a bearer token, so the token stays valid until it expires.",
+
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The username of a managed or LDAP user to log in as",
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password of the user",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The bearer token, for use in an `Authorization: Bearer <token>` header",
+			},
+		},
+	}
+}
+
+func (r *AuthTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *AuthTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data AuthTokenEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The login endpoint is unauthenticated, so use a client without
+	// credentials rather than the provider's, whose credentials belong to a
+	// different identity. It still shares the provider's transport.
+	client, err := dtrack.NewClient(r.data.Endpoint, withTransport(r.data.transport))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Temporary Client",
+			"An unexpected error occurred when creating a temporary Dependency-Track client for login. "+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	token, err := client.User.Login(ctx, data.Username.ValueString(), data.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Authentication Failed",
+			"Unable to authenticate with username and password. "+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	tflog.Trace(ctx, "opened an auth token ephemeral resource")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccAuthTokenEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAPIKey(t)
+			testAccPreCheckUsernamePassword(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAuthTokenEphemeralResourceConfig(
+					os.Getenv("DEPENDENCYTRACK_USERNAME"),
+					os.Getenv("DEPENDENCYTRACK_PASSWORD"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[^.]+\.[^.]+\.[^.]+$`)),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccAuthTokenEphemeralResource_InvalidCredentials(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAuthTokenEphemeralResourceConfig("tf-acc-nonexistent-user", "wrong-password"),
+				ExpectError: regexp.MustCompile(`Authentication Failed`),
+			},
+		},
+	})
+}
+
+func testAccAuthTokenEphemeralResourceConfig(username, password string) string {
+	return testAccProviderConfigWithAPIKey() + `
+ephemeral "dependencytrack_auth_token" "test" {
+  username = "` + username + `"
+  password = "` + password + `"
+}
+
+provider "echo" {
+  data = ephemeral.dependencytrack_auth_token.test.token
+}
+
+resource "echo" "test" {}
+`
+}
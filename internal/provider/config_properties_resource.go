@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConfigPropertiesResource{}
+
+func NewConfigPropertiesResource() resource.Resource {
+	return &ConfigPropertiesResource{}
+}
+
+// ConfigPropertiesResource defines the resource implementation.
+type ConfigPropertiesResource struct {
+	data *Data
+}
+
+// ConfigPropertiesResourceModel describes the resource data model.
+type ConfigPropertiesResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Properties types.Map    `tfsdk:"properties"`
+}
+
+func (r *ConfigPropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_properties"
+}
+
+func (r *ConfigPropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages several Dependency-Track configuration properties at once, " +
+			"for example to bootstrap the email or integration settings of a fresh instance in one place. " +
+			"OIDC and LDAP are not configuration properties: Dependency-Track reads them from its `ALPINE_OIDC_*` and `ALPINE_LDAP_*` " +
+			"startup settings, so they cannot be managed through the API. " +
+			"All values are written in a single request and then verified with a single read. " +
+			"Like `dependencytrack_config_property`, this resource only adopts predefined properties: " +
+			"properties removed from the map, or the whole resource when destroyed, keep their current value in Dependency-Track. " +
+			"Do not manage the same property with both resources.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource (always `config_properties`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Sensitive:   true,
+				MarkdownDescription: "Values of the managed config properties, keyed by `group_name/property_name` " +
+					"(the `dependencytrack_config_property` ID format), e.g. `email/smtp.enabled`. " +
+					"Sensitive because it may hold ENCRYPTEDSTRING properties such as `email/smtp.password`.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(configPropertyKeyValidator{}),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConfigPropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *ConfigPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ConfigPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("config_properties")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConfigPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior := make(map[string]string)
+	resp.Diagnostics.Append(data.Properties.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := r.data.Client.Config.GetAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config properties, got error: %s", err))
+		return
+	}
+
+	properties, diags := types.MapValueFrom(ctx, types.StringType, configPropertyValues(all, prior))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Properties = properties
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConfigPropertiesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConfigPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Config properties cannot be deleted from Dependency-Track; they keep
+	// their current value once the resource is removed from state.
+}
+
+// apply writes every property in data and records the values stored by the
+// server.
+func (r *ConfigPropertiesResource) apply(ctx context.Context, data *ConfigPropertiesResourceModel, diags *diag.Diagnostics) {
+	desired := make(map[string]string)
+	diags.Append(data.Properties.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return
+	}
+
+	all := updateConfigProperties(ctx, r.data.Client, desired, diags)
+	if diags.HasError() {
+		return
+	}
+
+	properties, d := types.MapValueFrom(ctx, types.StringType, configPropertyValues(all, desired))
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	data.Properties = properties
+}
+
+// updateConfigProperties writes desired (keyed by group_name/property_name)
+// with a single aggregate update and then reads all properties back once to
+// verify the values took effect. It returns all config properties as read
+// back, so callers can pick up the properties they did not write; on error it
+// returns nil.
+func updateConfigProperties(ctx context.Context, client *dtrack.Client, desired map[string]string, diags *diag.Diagnostics) []dtrack.ConfigProperty {
+	existing, err := client.Config.GetAll(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read config properties, got error: %s", err))
+		return nil
+	}
+
+	updates, missing := configPropertyUpdates(existing, desired)
+	if len(missing) > 0 {
+		diags.AddError(
+			"Config Property Not Found",
+			fmt.Sprintf("The following config properties do not exist: %s. Config properties must be predefined in Dependency-Track.", strings.Join(missing, ", ")),
+		)
+		return nil
+	}
+
+	// The aggregate endpoint reports per-property failures inside its
+	// response body instead of through the status code, so the response is
+	// not trusted; the read-back below is what confirms the update.
+	if _, err := client.Config.UpdateAll(ctx, updates); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update config properties, got error: %s", err))
+		return nil
+	}
+
+	all, err := client.Config.GetAll(ctx)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read config properties, got error: %s", err))
+		return nil
+	}
+
+	actual := configPropertyValues(all, desired)
+
+	var notApplied []string
+	for key, value := range desired {
+		if actual[key] != value {
+			notApplied = append(notApplied, fmt.Sprintf("%s (wanted %q, got %q)", key, value, actual[key]))
+		}
+	}
+	if len(notApplied) > 0 {
+		slices.Sort(notApplied)
+		diags.AddError(
+			"Config Properties Not Updated",
+			"Dependency-Track did not store the configured value of: "+strings.Join(notApplied, ", ")+
+				". Check the values against the property types.",
+		)
+		return nil
+	}
+
+	return all
+}
+
+// configPropertyKey returns the key of prop in the properties map.
+func configPropertyKey(prop dtrack.ConfigProperty) string {
+	return prop.GroupName + "/" + prop.Name
+}
+
+// configPropertyUpdates builds the aggregate update request for desired,
+// carrying each property's type over from existing. Keys of desired that
+// match no existing property are returned, sorted, as missing.
+func configPropertyUpdates(existing []dtrack.ConfigProperty, desired map[string]string) (updates []dtrack.ConfigProperty, missing []string) {
+	byKey := make(map[string]dtrack.ConfigProperty, len(existing))
+	for _, prop := range existing {
+		byKey[configPropertyKey(prop)] = prop
+	}
+
+	for key, value := range desired {
+		prop, ok := byKey[key]
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		updates = append(updates, dtrack.ConfigProperty{
+			GroupName: prop.GroupName,
+			Name:      prop.Name,
+			Type:      prop.Type,
+			Value:     value,
+		})
+	}
+
+	slices.Sort(missing)
+	slices.SortFunc(updates, func(a, b dtrack.ConfigProperty) int {
+		return strings.Compare(configPropertyKey(a), configPropertyKey(b))
+	})
+	return updates, missing
+}
+
+// configPropertyValues returns the server values of the properties keyed in
+// known. Properties that no longer exist are left out. ENCRYPTEDSTRING
+// properties are only ever returned as a placeholder, so their value in known
+// is kept instead.
+func configPropertyValues(all []dtrack.ConfigProperty, known map[string]string) map[string]string {
+	values := make(map[string]string, len(known))
+	for _, prop := range all {
+		key := configPropertyKey(prop)
+		value, ok := known[key]
+		if !ok {
+			continue
+		}
+		if prop.Type == "ENCRYPTEDSTRING" && prop.Value == encryptedStringPlaceholder {
+			values[key] = value
+			continue
+		}
+		values[key] = prop.Value
+	}
+	return values
+}
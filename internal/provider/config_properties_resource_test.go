@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccConfigPropertiesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigPropertiesResourceConfig("https://bulk.example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_config_properties.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("config_properties"),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_config_properties.test",
+						tfjsonpath.New("properties"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"general/base.url": knownvalue.StringExact("https://bulk.example.com"),
+						}),
+					),
+				},
+			},
+			{
+				Config: testAccConfigPropertiesResourceConfig("https://bulk-updated.example.com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_config_properties.test",
+						tfjsonpath.New("properties").AtMapKey("general/base.url"),
+						knownvalue.StringExact("https://bulk-updated.example.com"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccConfigPropertiesResource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_config_properties" "test" {
+  properties = {
+    "general/base.url"       = "https://bulk.example.com"
+    "general/does.not.exist" = "value"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`general/does\.not\.exist`),
+			},
+		},
+	})
+}
+
+func testAccConfigPropertiesResourceConfig(baseURL string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_config_properties" "test" {
+  properties = {
+    "general/base.url" = %q
+  }
+}
+`, baseURL)
+}
+
+func TestConfigPropertyUpdates(t *testing.T) {
+	existing := []dtrack.ConfigProperty{
+		{GroupName: "general", Name: "base.url", Type: "URL", Value: "https://old.example.com"},
+		{GroupName: "email", Name: "smtp.enabled", Type: "BOOLEAN", Value: "false"},
+		{GroupName: "email", Name: "smtp.port", Type: "INTEGER", Value: "25"},
+	}
+
+	updates, missing := configPropertyUpdates(existing, map[string]string{
+		"general/base.url":   "https://new.example.com",
+		"email/smtp.enabled": "true",
+		"ldap/missing":       "x",
+		"email/unknown":      "y",
+	})
+
+	want := []dtrack.ConfigProperty{
+		{GroupName: "email", Name: "smtp.enabled", Type: "BOOLEAN", Value: "true"},
+		{GroupName: "general", Name: "base.url", Type: "URL", Value: "https://new.example.com"},
+	}
+	if !slices.Equal(updates, want) {
+		t.Errorf("updates = %+v, want %+v", updates, want)
+	}
+	if wantMissing := []string{"email/unknown", "ldap/missing"}; !slices.Equal(missing, wantMissing) {
+		t.Errorf("missing = %v, want %v", missing, wantMissing)
+	}
+}
+
+func TestConfigPropertyValues(t *testing.T) {
+	all := []dtrack.ConfigProperty{
+		{GroupName: "general", Name: "base.url", Type: "URL", Value: "https://server.example.com"},
+		{GroupName: "email", Name: "smtp.password", Type: "ENCRYPTEDSTRING", Value: encryptedStringPlaceholder},
+		{GroupName: "email", Name: "smtp.port", Type: "INTEGER", Value: "25"},
+	}
+
+	got := configPropertyValues(all, map[string]string{
+		"general/base.url":    "https://state.example.com",
+		"email/smtp.password": "secret",
+		"ldap/removed":        "gone",
+	})
+
+	want := map[string]string{
+		"general/base.url":    "https://server.example.com",
+		"email/smtp.password": "secret",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %q, want %q", k, got[k], v)
+		}
+	}
+}
@@ -37,7 +37,9 @@ func (d *ConfigPropertyDataSource) Metadata(ctx context.Context, req datasource.
 
 func (d *ConfigPropertyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Retrieves information about a Dependency-Track configuration property.",
+		MarkdownDescription: "Retrieves information about a Dependency-Track configuration property. " +
+			"Values of ENCRYPTEDSTRING properties are never returned in plain text by Dependency-Track, and there is no way to request decryption; " +
+			"for those properties `value` is always null.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -54,11 +56,12 @@ func (d *ConfigPropertyDataSource) Schema(ctx context.Context, req datasource.Sc
 			},
 			"value": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "The value of the config property",
+				MarkdownDescription: "The value of the config property. Always null for ENCRYPTEDSTRING properties, whose value the server hides behind a placeholder.",
 			},
 			"type": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "The type of the config property (BOOLEAN, INTEGER, NUMBER, STRING, ENCRYPTEDSTRING, TIMESTAMP, URL, UUID)",
+				Computed: true,
+				MarkdownDescription: "The type of the config property (BOOLEAN, INTEGER, NUMBER, STRING, ENCRYPTEDSTRING, TIMESTAMP, URL, UUID). " +
+					"The ENCRYPTEDSTRING type only exists on Dependency-Track v4; v5 exposes no ENCRYPTEDSTRING config properties.",
 			},
 			"description": schema.StringAttribute{
 				Computed:            true,
@@ -111,7 +114,15 @@ func (d *ConfigPropertyDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	data.ID = types.StringValue(fmt.Sprintf("%s/%s", prop.GroupName, prop.Name))
-	data.Value = types.StringValue(prop.Value)
+
+	// Encrypted properties come back as a fixed placeholder rather than the
+	// real value. Expose them as null so configs can't accidentally feed the
+	// placeholder string back into Dependency-Track.
+	if prop.Type == "ENCRYPTEDSTRING" && prop.Value == encryptedStringPlaceholder {
+		data.Value = types.StringNull()
+	} else {
+		data.Value = types.StringValue(prop.Value)
+	}
 	data.Type = types.StringValue(prop.Type)
 	data.Description = types.StringValue(prop.Description)
 
@@ -52,6 +52,36 @@ func TestAccConfigPropertyDataSource(t *testing.T) {
 	})
 }
 
+// TestAccConfigPropertyDataSource_EncryptedString tests that the data source
+// reports ENCRYPTEDSTRING values as null instead of the server's
+// "HiddenDecryptedPropertyPlaceholder" sentinel.
+//
+// It is gated to Dependency-Track v4: v5 exposes no ENCRYPTEDSTRING config
+// properties.
+func TestAccConfigPropertyDataSource_EncryptedString(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t); testAccSkipUnlessV4(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigPropertyDataSourceEncryptedConfigWithAPIKey,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_config_property.test",
+						tfjsonpath.New("type"),
+						knownvalue.StringExact("ENCRYPTEDSTRING"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_config_property.test",
+						tfjsonpath.New("value"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
 var testAccConfigPropertyDataSourceConfigWithAPIKey = testAccProviderConfigWithAPIKey() + `
 resource "dependencytrack_config_property" "test" {
   group_name = "general"
@@ -64,3 +94,16 @@ data "dependencytrack_config_property" "test" {
   name       = dependencytrack_config_property.test.name
 }
 `
+
+var testAccConfigPropertyDataSourceEncryptedConfigWithAPIKey = testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_config_property" "test" {
+  group_name = "email"
+  name       = "smtp.password"
+  value      = "datasource-password-123"
+}
+
+data "dependencytrack_config_property" "test" {
+  group_name = dependencytrack_config_property.test.group_name
+  name       = dependencytrack_config_property.test.name
+}
+`
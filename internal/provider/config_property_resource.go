@@ -155,14 +155,7 @@ func (r *ConfigPropertyResource) Create(ctx context.Context, req resource.Create
 	// Set the ID and all attributes in state
 	data.ID = types.StringValue(fmt.Sprintf("%s/%s", updatedProp.GroupName, updatedProp.Name))
 
-	// For encrypted properties, the API returns a placeholder instead of the actual value
-	// We need to preserve the configured value in state
-	if updatedProp.Type == "ENCRYPTEDSTRING" && updatedProp.Value == "HiddenDecryptedPropertyPlaceholder" {
-		// Keep the configured value that was set in data.Value
-		// Don't update it with the placeholder from the API
-	} else {
-		data.Value = types.StringValue(updatedProp.Value)
-	}
+	r.setValue(&data, updatedProp)
 
 	data.Type = types.StringValue(updatedProp.Type)
 	data.Description = types.StringValue(updatedProp.Description)
@@ -191,14 +184,9 @@ func (r *ConfigPropertyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	// For encrypted properties, the API returns a placeholder instead of the actual value
-	// We need to preserve the existing state value
-	if prop.Type == "ENCRYPTEDSTRING" && prop.Value == "HiddenDecryptedPropertyPlaceholder" {
-		// Keep the existing value from state (data.Value)
-		// Don't update it with the placeholder from the API
-	} else {
-		data.Value = types.StringValue(prop.Value)
-	}
+	// Refreshing an encrypted property must not overwrite the real secret
+	// held in prior state with the server's placeholder.
+	r.setValue(&data, prop)
 
 	data.Type = types.StringValue(prop.Type)
 	data.Description = types.StringValue(prop.Description)
@@ -235,14 +223,7 @@ func (r *ConfigPropertyResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// For encrypted properties, the API returns a placeholder instead of the actual value
-	// We need to preserve the configured value in state
-	if updatedProp.Type == "ENCRYPTEDSTRING" && updatedProp.Value == "HiddenDecryptedPropertyPlaceholder" {
-		// Keep the configured value that was set in data.Value
-		// Don't update it with the placeholder from the API
-	} else {
-		data.Value = types.StringValue(updatedProp.Value)
-	}
+	r.setValue(&data, updatedProp)
 
 	data.Type = types.StringValue(updatedProp.Type)
 	data.Description = types.StringValue(updatedProp.Description)
@@ -283,6 +264,17 @@ func (r *ConfigPropertyResource) ImportState(ctx context.Context, req resource.I
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), propertyName)...)
 }
 
+// setValue records the property value in state. For ENCRYPTEDSTRING properties
+// the API returns a placeholder instead of the real value, so the value
+// already in the model (the configured value on create/update, the prior
+// state value on read) is preserved.
+func (r *ConfigPropertyResource) setValue(data *ConfigPropertyResourceModel, prop dtrack.ConfigProperty) {
+	if prop.Type == "ENCRYPTEDSTRING" && prop.Value == encryptedStringPlaceholder {
+		return
+	}
+	data.Value = types.StringValue(prop.Value)
+}
+
 // parseConfigPropertyID parses a config property ID in the format "group_name/property_name".
 func parseConfigPropertyID(id string) (groupName, propertyName string, err error) {
 	// Find the first slash to split group_name and property_name
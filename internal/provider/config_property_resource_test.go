@@ -134,6 +134,19 @@ func TestAccConfigPropertyResource_EncryptedString(t *testing.T) {
 					),
 				},
 			},
+			// Refresh testing - the server only ever returns a placeholder for the
+			// value, which must not overwrite the real secret held in state
+			{
+				RefreshState: true,
+				Check: resource.TestCheckResourceAttr(
+					"dependencytrack_config_property.test", "value", "final-password-789",
+				),
+			},
+			// The refreshed state must still match the configuration
+			{
+				Config:   testAccConfigPropertyResourceConfigWithAPIKey("email", "smtp.password", "final-password-789"),
+				PlanOnly: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
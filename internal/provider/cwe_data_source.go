@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CWEDataSource{}
+
+func NewCWEDataSource() datasource.DataSource {
+	return &CWEDataSource{}
+}
+
+// CWEDataSource defines the data source implementation.
+type CWEDataSource struct {
+	data *Data
+}
+
+// CWEDataSourceModel describes the data source data model.
+type CWEDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	CWEID types.Int64  `tfsdk:"cwe_id"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func (d *CWEDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cwe"
+}
+
+func (d *CWEDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches a CWE from the catalog bundled with Dependency-Track by number or name, e.g. to reference it in a `CWE` policy condition. " +
+			"Exactly one of `cwe_id` or `name` must be specified.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the CWE in the `CWE-<number>` form accepted by `CWE` policy conditions, e.g. `CWE-79`.",
+			},
+			"cwe_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The number of the CWE, e.g. `79`. Exactly one of `cwe_id` or `name` must be specified.",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The name of the CWE, e.g. `Improper Neutralization of Input During Web Page Generation ('Cross-site Scripting')`. " +
+					"Matched case-insensitively against the whole name. Exactly one of `cwe_id` or `name` must be specified.",
+			},
+		},
+	}
+}
+
+func (d *CWEDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *CWEDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CWEDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// client-go has a CWE type but no service for the /api/v1/cwe endpoints,
+	// so they are called directly.
+	var cwe dtrack.CWE
+
+	if !data.CWEID.IsNull() {
+		cweID := data.CWEID.ValueInt64()
+
+		err := d.data.API().Do(ctx, http.MethodGet, "/api/v1/cwe/"+strconv.FormatInt(cweID, 10), nil, &cwe)
+		if isNotFound(err) {
+			resp.Diagnostics.AddError("CWE Not Found", fmt.Sprintf("No CWE found with ID: %d", cweID))
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CWE, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "read CWE data source by ID")
+	} else {
+		searchName := strings.TrimSpace(data.Name.ValueString())
+
+		cwes, err := apiGetAllPages[dtrack.CWE](ctx, d.data.API(), "/api/v1/cwe", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CWEs, got error: %s", err))
+			return
+		}
+
+		found := false
+		for _, c := range cwes {
+			if strings.EqualFold(c.Name, searchName) {
+				cwe, found = c, true
+				break
+			}
+		}
+
+		if !found {
+			resp.Diagnostics.AddError("CWE Not Found", fmt.Sprintf("No CWE found with name: %s", searchName))
+			return
+		}
+
+		tflog.Trace(ctx, "read CWE data source by name")
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("CWE-%d", cwe.ID))
+	data.CWEID = types.Int64Value(int64(cwe.ID))
+	data.Name = types.StringValue(cwe.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
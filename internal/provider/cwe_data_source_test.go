@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+const testAccCWE79Name = "Improper Neutralization of Input During Web Page Generation ('Cross-site Scripting')"
+
+func TestAccCWEDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCWEDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_cwe.by_id",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("CWE-79"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_cwe.by_id",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact(testAccCWE79Name),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_cwe.by_name",
+						tfjsonpath.New("cwe_id"),
+						knownvalue.Int64Exact(79),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccCWEDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+data "dependencytrack_cwe" "test" {
+  name = "tf-acc-no-such-cwe"
+}
+`,
+				ExpectError: regexp.MustCompile(`CWE Not Found`),
+			},
+		},
+	})
+}
+
+var testAccCWEDataSourceConfig = testAccProviderConfigWithAPIKey() + `
+data "dependencytrack_cwe" "by_id" {
+  cwe_id = 79
+}
+
+data "dependencytrack_cwe" "by_name" {
+  name = "improper neutralization of input during web page generation ('cross-site scripting')"
+}
+`
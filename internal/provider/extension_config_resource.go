@@ -161,7 +161,7 @@ func (r *ExtensionConfigResource) putExtensionConfig(ctx context.Context, data *
 			)
 			return false
 		}
-		diags.AddError("Client Error", fmt.Sprintf("Unable to update extension config, got error: %s", err))
+		addAPIErrorDiagnostic(diags, "update extension config", err)
 		return false
 	}
 
@@ -212,7 +212,7 @@ func (r *ExtensionConfigResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read extension config, got error: %s", err))
+		addAPIErrorDiagnostic(&resp.Diagnostics, "read extension config", err)
 		return
 	}
 
@@ -1,13 +1,17 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // secretNameRegex is the server-side pattern for secret names, from the
@@ -32,6 +36,43 @@ func requireV5(data *Data, resourceName string, diags *diag.Diagnostics) bool {
 	return false
 }
 
+// requireV4 reports whether the configured server runs Dependency-Track v4,
+// adding an error naming resourceName (and pointing at replacement, the way
+// the same settings are managed on v5) when it does not.
+func requireV4(data *Data, resourceName, replacement string, diags *diag.Diagnostics) bool {
+	if !data.IsV5() {
+		return true
+	}
+
+	diags.AddError(
+		"Dependency-Track v4 Required",
+		fmt.Sprintf("%s manages settings that only exist on Dependency-Track v4; the configured server reports version %d.%d. %s",
+			resourceName, data.ServerVersion.Major, data.ServerVersion.Minor, replacement),
+	)
+	return false
+}
+
+// requireServerVersion verifies that the configured server is at least
+// major.minor before attr, which was introduced in that release, is used.
+// Older servers silently drop fields they don't know, which would otherwise
+// surface as a confusing perpetual diff. Resources call this at plan time for
+// attributes that are set in the configuration; it appends an attribute error
+// and returns false when the server is too old.
+func requireServerVersion(data *Data, attr path.Path, major, minor int, diags *diag.Diagnostics) bool {
+	if data.ServerVersion.AtLeast(major, minor) {
+		return true
+	}
+
+	diags.AddAttributeError(
+		attr,
+		"Unsupported Dependency-Track Version",
+		fmt.Sprintf("%s requires Dependency-Track %d.%d or newer, but the configured server reports version %d.%d. "+
+			"Remove the attribute from the configuration or upgrade the server.",
+			attr, major, minor, data.ServerVersion.Major, data.ServerVersion.Minor),
+	)
+	return false
+}
+
 // parseCompositeID parses a composite ID in the format "part1/part2" and returns the two parts.
 // The partNames are used for error messages to make them more descriptive.
 func parseCompositeID(id string, part1Name, part2Name string) (string, string, error) {
@@ -43,6 +84,27 @@ func parseCompositeID(id string, part1Name, part2Name string) (string, string, e
 	return parts[0], parts[1], nil
 }
 
+// parseCompositeUUIDID parses a composite ID in the format "part1/part2" where
+// both parts are UUIDs. Errors name the part that failed to parse.
+func parseCompositeUUIDID(id string, part1Name, part2Name string) (uuid.UUID, uuid.UUID, error) {
+	part1, part2, err := parseCompositeID(id, part1Name, part2Name)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	uuid1, err := uuid.Parse(part1)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid %s %q: %w", part1Name, part1, err)
+	}
+
+	uuid2, err := uuid.Parse(part2)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid %s %q: %w", part2Name, part2, err)
+	}
+
+	return uuid1, uuid2, nil
+}
+
 // parseCompositeID3 parses a composite ID in the format "part1/part2/part3" and
 // returns the three parts. The partNames are used for error messages to make
 // them more descriptive.
@@ -55,6 +117,44 @@ func parseCompositeID3(id string, part1Name, part2Name, part3Name string) (strin
 	return parts[0], parts[1], parts[2], nil
 }
 
+// canonicalUUIDs returns values with every UUID in its canonical lower-case
+// form, so that sets of UUIDs from the configuration and from the server can
+// be compared. Values that are not UUIDs are returned unchanged.
+func canonicalUUIDs(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if id, err := uuid.Parse(value); err == nil {
+			value = id.String()
+		}
+		out = append(out, value)
+	}
+	return out
+}
+
+// uuidsInPriorSpelling returns actual with every UUID that also appears in
+// prior spelled as it is in prior. Dependency-Track returns UUIDs in lower
+// case; keeping the configured spelling of the same UUID avoids a perpetual
+// diff for configurations that use upper case.
+func uuidsInPriorSpelling(actual, prior []string) []string {
+	spelling := make(map[string]string, len(prior))
+	for _, value := range prior {
+		if id, err := uuid.Parse(value); err == nil {
+			spelling[id.String()] = value
+		}
+	}
+
+	out := make([]string, 0, len(actual))
+	for _, value := range actual {
+		if id, err := uuid.Parse(value); err == nil {
+			if prior, ok := spelling[id.String()]; ok {
+				value = prior
+			}
+		}
+		out = append(out, value)
+	}
+	return out
+}
+
 // jsonStringsEquivalent reports whether a and b encode the same JSON value,
 // ignoring formatting differences such as whitespace and key order. If either
 // string is not valid JSON, it falls back to plain string comparison.
@@ -85,3 +185,53 @@ func canonicalJSONString(s string) string {
 	}
 	return string(b)
 }
+
+// Timing knobs for retryRead, overridable in unit tests.
+var (
+	readRetryAttempts = 5
+	readRetryBackoff  = 250 * time.Millisecond
+)
+
+// retryRead calls get until pending reports the result as settled by
+// returning nil, waiting with a doubling backoff in between. Dependency-Track
+// can briefly serve stale data right after a write, either because a
+// clustered node does not see it yet or because of its own caches, and
+// reading that back into state would make the resource look wrong right after
+// apply. pending returns the reason the read is not settled yet, which is
+// returned as the error once the attempts run out; results it accepts are
+// returned as is, including errors from get.
+func retryRead[T any](ctx context.Context, get func(context.Context) (T, error), pending func(T, error) error) (T, error) {
+	backoff := readRetryBackoff
+
+	for attempt := 1; ; attempt++ {
+		obj, err := get(ctx)
+		reason := pending(obj, err)
+		if reason == nil {
+			return obj, err
+		}
+		if attempt >= readRetryAttempts {
+			return obj, reason
+		}
+
+		select {
+		case <-ctx.Done():
+			return obj, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// getCreated reads an object right after it was created. On clustered
+// Dependency-Track deployments the read can reach a node that does not see
+// the new object yet and answer 404, which would make the resource look
+// missing right after creating it. Not-found responses are therefore retried
+// via retryRead; any other error is returned at once.
+func getCreated[T any](ctx context.Context, get func(context.Context) (T, error)) (T, error) {
+	return retryRead(ctx, get, func(_ T, err error) error {
+		if isNotFound(err) {
+			return err
+		}
+		return nil
+	})
+}
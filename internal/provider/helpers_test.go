@@ -1,6 +1,18 @@
 package provider
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
 
 func TestParseCompositeID3(t *testing.T) {
 	tests := []struct {
@@ -34,6 +46,43 @@ func TestParseCompositeID3(t *testing.T) {
 	}
 }
 
+func TestParseCompositeUUIDID(t *testing.T) {
+	const (
+		team    = "00000000-0000-0000-0000-000000000001"
+		project = "00000000-0000-0000-0000-000000000002"
+	)
+
+	tests := []struct {
+		name    string
+		id      string
+		wantErr string
+	}{
+		{"two uuids", team + "/" + project, ""},
+		{"too few parts", team, "expected format 'team_uuid/project_uuid'"},
+		{"too many parts", team + "/" + project + "/x", "expected format 'team_uuid/project_uuid'"},
+		{"invalid first part", "team/" + project, `invalid team_uuid "team"`},
+		{"invalid second part", team + "/project", `invalid project_uuid "project"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got1, got2, err := parseCompositeUUIDID(tt.id, "team_uuid", "project_uuid")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseCompositeUUIDID(%q) error = %v, want it to contain %q", tt.id, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseCompositeUUIDID(%q) unexpected error: %v", tt.id, err)
+			}
+			if got1.String() != team || got2.String() != project {
+				t.Errorf("parseCompositeUUIDID(%q) = (%s, %s), want (%s, %s)", tt.id, got1, got2, team, project)
+			}
+		})
+	}
+}
+
 func TestJSONStringsEquivalent(t *testing.T) {
 	tests := []struct {
 		name string
@@ -79,3 +128,113 @@ func TestCanonicalJSONString(t *testing.T) {
 		})
 	}
 }
+
+func TestRequireServerVersion(t *testing.T) {
+	tests := []struct {
+		name   string
+		server string
+		major  int
+		minor  int
+		want   bool
+	}{
+		{name: "same version", server: "4.13.0", major: 4, minor: 13, want: true},
+		{name: "newer minor", server: "4.14.1", major: 4, minor: 13, want: true},
+		{name: "newer major", server: "5.0.0", major: 4, minor: 13, want: true},
+		{name: "older minor", server: "4.12.7", major: 4, minor: 13, want: false},
+		{name: "older major", server: "4.14.0", major: 5, minor: 0, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := parseServerVersion(tt.server)
+			if err != nil {
+				t.Fatalf("parseServerVersion(%q): %s", tt.server, err)
+			}
+
+			var diags diag.Diagnostics
+			got := requireServerVersion(&Data{ServerVersion: v}, path.Root("collection_logic"), tt.major, tt.minor, &diags)
+			if got != tt.want {
+				t.Errorf("requireServerVersion() = %v, want %v", got, tt.want)
+			}
+			if diags.HasError() == tt.want {
+				t.Errorf("HasError() = %v, want %v", diags.HasError(), !tt.want)
+			}
+			if !tt.want && !strings.Contains(diags.Errors()[0].Detail(), "collection_logic requires Dependency-Track") {
+				t.Errorf("unexpected detail: %s", diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
+
+// fastReadRetries shrinks the retryRead knobs for the duration of a test so
+// the retry paths don't slow the unit test suite down.
+func fastReadRetries(t *testing.T) {
+	t.Helper()
+
+	origAttempts, origBackoff := readRetryAttempts, readRetryBackoff
+	readRetryAttempts = 3
+	readRetryBackoff = time.Millisecond
+	t.Cleanup(func() {
+		readRetryAttempts, readRetryBackoff = origAttempts, origBackoff
+	})
+}
+
+func TestGetCreated(t *testing.T) {
+	notFound := &dtrack.APIError{StatusCode: http.StatusNotFound, Message: "not found"}
+	serverError := &dtrack.APIError{StatusCode: http.StatusInternalServerError, Message: "boom"}
+
+	tests := []struct {
+		name      string
+		errs      []error // returned by successive reads; reads past the end succeed
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "immediate", wantCalls: 1},
+		{name: "eventually visible", errs: []error{notFound, notFound}, wantCalls: 3},
+		{name: "never visible", errs: []error{notFound, notFound, notFound, notFound}, wantCalls: 3, wantErr: notFound},
+		{name: "other error not retried", errs: []error{serverError}, wantCalls: 1, wantErr: serverError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fastReadRetries(t)
+
+			calls := 0
+			got, err := getCreated(context.Background(), func(context.Context) (string, error) {
+				calls++
+				if calls <= len(tt.errs) {
+					return "", tt.errs[calls-1]
+				}
+				return "created", nil
+			})
+			if calls != tt.wantCalls {
+				t.Errorf("got %d reads, want %d", calls, tt.wantCalls)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got != "created" {
+				t.Errorf("got %q, want %q", got, "created")
+			}
+		})
+	}
+}
+
+func TestCanonicalUUIDs(t *testing.T) {
+	got := canonicalUUIDs([]string{"0B9E3C39-2E4C-4A8B-9F1D-6C2A7E5B4D10", "not-a-uuid"})
+	want := []string{"0b9e3c39-2e4c-4a8b-9f1d-6c2a7e5b4d10", "not-a-uuid"}
+	if !slices.Equal(got, want) {
+		t.Errorf("canonicalUUIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestUUIDsInPriorSpelling(t *testing.T) {
+	actual := []string{"0b9e3c39-2e4c-4a8b-9f1d-6c2a7e5b4d10", "7f3c1e2a-5b6d-4c8e-9a0b-1d2e3f4a5b6c"}
+	prior := []string{"0B9E3C39-2E4C-4A8B-9F1D-6C2A7E5B4D10", "not-a-uuid"}
+
+	got := uuidsInPriorSpelling(actual, prior)
+	want := []string{"0B9E3C39-2E4C-4A8B-9F1D-6C2A7E5B4D10", "7f3c1e2a-5b6d-4c8e-9a0b-1d2e3f4a5b6c"}
+	if !slices.Equal(got, want) {
+		t.Errorf("uuidsInPriorSpelling() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &JiraConfigResource{}
+var _ resource.ResourceWithImportState = &JiraConfigResource{}
+
+// Keys of the integrations config properties managed by
+// dependencytrack_jira_config, in the group_name/property_name format of the
+// config_properties resource.
+const (
+	jiraURLKey      = "integrations/jira.url"
+	jiraUsernameKey = "integrations/jira.username"
+	jiraPasswordKey = "integrations/jira.password"
+)
+
+func NewJiraConfigResource() resource.Resource {
+	return &JiraConfigResource{}
+}
+
+// JiraConfigResource defines the resource implementation.
+type JiraConfigResource struct {
+	data *Data
+}
+
+// JiraConfigResourceModel describes the resource data model.
+type JiraConfigResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	URL      types.String `tfsdk:"url"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+}
+
+func (r *JiraConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_config"
+}
+
+func (r *JiraConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the Jira server Dependency-Track creates tickets on for notification rules with the Jira publisher, " +
+			"i.e. the `jira.*` config properties of the `integrations` group, written in a single update. " +
+			"The Jira project key and ticket type are not global settings: set them per notification rule in its `publisher_config` " +
+			"(`jiraProjectKey` and `jiraTicketType`). " +
+			"When destroyed, the settings are only removed from Terraform state and keep their current values. " +
+			"Do not manage the same properties with `dependencytrack_config_property` or `dependencytrack_config_properties`. " +
+			"Only available on Dependency-Track v4; v5 configures Jira through the Jira notification publisher extension (see `dependencytrack_extension_config`).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource (always `jira_config`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Base URL of the Jira server, e.g. `https://example.atlassian.net`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username to authenticate with",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "Password or API token to authenticate with. Dependency-Track stores it encrypted and never returns it, " +
+					"so changes made outside of Terraform are not detected.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *JiraConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *JiraConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data JiraConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("jira_config")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JiraConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data JiraConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !requireV4(r.data, "dependencytrack_jira_config", jiraConfigV5Hint, &resp.Diagnostics) {
+		return
+	}
+
+	all, err := r.data.Client.Config.GetAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config properties, got error: %s", err))
+		return
+	}
+
+	setJiraConfigState(&data, configPropertyValues(all, jiraConfigKnownValues(data)))
+	data.ID = types.StringValue("jira_config")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JiraConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data JiraConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *JiraConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Config properties cannot be deleted from Dependency-Track; the Jira
+	// settings keep their current values once the resource is removed from
+	// state.
+}
+
+func (r *JiraConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is a single Jira configuration, so any import ID adopts it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "jira_config")...)
+}
+
+// jiraConfigV5Hint tells v5 users where the Jira settings moved to.
+const jiraConfigV5Hint = "On Dependency-Track v5, configure Jira through the Jira notification publisher extension " +
+	"with dependencytrack_extension_config instead."
+
+// apply writes the configured Jira settings and records the values stored by
+// the server in data.
+func (r *JiraConfigResource) apply(ctx context.Context, data *JiraConfigResourceModel, diags *diag.Diagnostics) {
+	if !requireV4(r.data, "dependencytrack_jira_config", jiraConfigV5Hint, diags) {
+		return
+	}
+
+	all := updateConfigProperties(ctx, r.data.Client, jiraConfigProperties(*data), diags)
+	if diags.HasError() {
+		return
+	}
+
+	setJiraConfigState(data, configPropertyValues(all, jiraConfigKnownValues(*data)))
+}
+
+// jiraConfigProperties returns the config property values for data. Unset
+// username and password are written as empty, so removing them from the
+// configuration clears the credentials.
+func jiraConfigProperties(data JiraConfigResourceModel) map[string]string {
+	return map[string]string{
+		jiraURLKey:      data.URL.ValueString(),
+		jiraUsernameKey: data.Username.ValueString(),
+		jiraPasswordKey: data.Password.ValueString(),
+	}
+}
+
+// jiraConfigKnownValues lists every managed property for configPropertyValues,
+// so all of them are read back. The password in data stands in for the
+// placeholder the server returns instead of the real value.
+func jiraConfigKnownValues(data JiraConfigResourceModel) map[string]string {
+	return map[string]string{
+		jiraURLKey:      "",
+		jiraUsernameKey: "",
+		jiraPasswordKey: data.Password.ValueString(),
+	}
+}
+
+// setJiraConfigState copies the config property values in values into data.
+// Properties missing from values keep the value already in data, and empty
+// values become null.
+func setJiraConfigState(data *JiraConfigResourceModel, values map[string]string) {
+	setString := func(key string, attr *types.String) {
+		value, ok := values[key]
+		if !ok {
+			return
+		}
+		if value == "" {
+			*attr = types.StringNull()
+			return
+		}
+		*attr = types.StringValue(value)
+	}
+
+	setString(jiraURLKey, &data.URL)
+	setString(jiraUsernameKey, &data.Username)
+	setString(jiraPasswordKey, &data.Password)
+}
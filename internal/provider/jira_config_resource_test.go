@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// TestAccJiraConfigResource is gated to Dependency-Track v4, the only major
+// version with Jira config properties.
+func TestAccJiraConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t); testAccSkipUnlessV4(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_jira_config" "test" {
+  url      = "https://jira.example.com"
+  username = "dtrack"
+  password = "initial-password"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_jira_config.test", tfjsonpath.New("url"), knownvalue.StringExact("https://jira.example.com")),
+					statecheck.ExpectKnownValue("dependencytrack_jira_config.test", tfjsonpath.New("username"), knownvalue.StringExact("dtrack")),
+					statecheck.ExpectKnownValue("dependencytrack_jira_config.test", tfjsonpath.New("password"), knownvalue.StringExact("initial-password")),
+				},
+			},
+			{
+				ResourceName:            "dependencytrack_jira_config.test",
+				ImportState:             true,
+				ImportStateId:           "jira_config",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Dropping the credentials clears them.
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_jira_config" "test" {
+  url = "https://jira2.example.com"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_jira_config.test", tfjsonpath.New("url"), knownvalue.StringExact("https://jira2.example.com")),
+					statecheck.ExpectKnownValue("dependencytrack_jira_config.test", tfjsonpath.New("username"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("dependencytrack_jira_config.test", tfjsonpath.New("password"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestSetJiraConfigState(t *testing.T) {
+	data := JiraConfigResourceModel{
+		URL:      types.StringValue("https://old.example.com"),
+		Username: types.StringValue("old"),
+		Password: types.StringValue("secret"),
+	}
+
+	setJiraConfigState(&data, map[string]string{
+		jiraURLKey:      "https://jira.example.com",
+		jiraUsernameKey: "",
+	})
+
+	if data.URL.ValueString() != "https://jira.example.com" {
+		t.Errorf("url = %v, want https://jira.example.com", data.URL)
+	}
+	if !data.Username.IsNull() {
+		t.Errorf("username = %v, want null", data.Username)
+	}
+	if data.Password.ValueString() != "secret" {
+		t.Errorf("password = %v, want it kept when missing from values", data.Password)
+	}
+}
@@ -142,6 +142,10 @@ func (r *LDAPMappingResource) Read(ctx context.Context, req resource.ReadRequest
 
 	mappings, err := r.data.Client.LDAP.GetTeamMappings(ctx, teamUUID)
 	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LDAP mappings, got error: %s", err))
 		return
 	}
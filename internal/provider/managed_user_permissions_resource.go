@@ -3,11 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -32,6 +36,7 @@ type ManagedUserPermissionsResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	User        types.String `tfsdk:"user"`
 	Permissions types.Set    `tfsdk:"permissions"`
+	Exclusive   types.Bool   `tfsdk:"exclusive"`
 }
 
 func (r *ManagedUserPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,7 +45,7 @@ func (r *ManagedUserPermissionsResource) Metadata(ctx context.Context, req resou
 
 func (r *ManagedUserPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages permissions for a managed user in Dependency-Track. This resource manages the complete set of permissions assigned to a managed user. Available permissions: ACCESS_MANAGEMENT, BOM_UPLOAD, POLICY_MANAGEMENT, POLICY_VIOLATION_ANALYSIS, PORTFOLIO_MANAGEMENT, PROJECT_CREATION_UPLOAD, SYSTEM_CONFIGURATION, TAG_MANAGEMENT, VIEW_BADGES, VIEW_POLICY_VIOLATION, VIEW_PORTFOLIO, VIEW_VULNERABILITY, VULNERABILITY_ANALYSIS, VULNERABILITY_MANAGEMENT.",
+		MarkdownDescription: "Manages permissions for a managed user in Dependency-Track. By default this resource manages the complete set of permissions assigned to a managed user; see `exclusive`. Available permissions: ACCESS_MANAGEMENT, BOM_UPLOAD, POLICY_MANAGEMENT, POLICY_VIOLATION_ANALYSIS, PORTFOLIO_MANAGEMENT, PROJECT_CREATION_UPLOAD, SYSTEM_CONFIGURATION, TAG_MANAGEMENT, VIEW_BADGES, VIEW_POLICY_VIOLATION, VIEW_PORTFOLIO, VIEW_VULNERABILITY, VULNERABILITY_ANALYSIS, VULNERABILITY_MANAGEMENT.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -65,6 +70,14 @@ func (r *ManagedUserPermissionsResource) Schema(ctx context.Context, req resourc
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"exclusive": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Whether `permissions` is the complete set of permissions of the user. When `true`, permissions granted outside of Terraform show up as drift and are revoked on the next apply. " +
+					"When `false`, only the configured permissions are granted and tracked, and removing one from `permissions` or destroying the resource only revokes permissions that were in `permissions`; others are left alone. " +
+					"Switching from `true` to `false` revokes nothing. This is a Terraform-only setting that is not stored in Dependency-Track. Defaults to `true`.",
+			},
 		},
 	}
 }
@@ -87,18 +100,77 @@ func (r *ManagedUserPermissionsResource) Configure(ctx context.Context, req reso
 	r.data = data
 }
 
-func (r *ManagedUserPermissionsResource) addPermissionToUser(ctx context.Context, username, permission string) error {
+// addPermissionToUser grants permission to the user and reports whether it
+// was added, as opposed to being held already.
+func (r *ManagedUserPermissionsResource) addPermissionToUser(ctx context.Context, username, permission string) (bool, error) {
+	defer managedUsers.invalidate(r.data.Endpoint)
 	_, err := r.data.Client.Permission.AddPermissionToUser(ctx, dtrack.Permission{Name: permission}, username)
-	return err
+	if isPermissionUnchanged(err) {
+		return false, nil
+	}
+	return err == nil, err
 }
 
+// removePermissionFromUser revokes permission from the user. Revoking a
+// permission the user does not hold succeeds.
 func (r *ManagedUserPermissionsResource) removePermissionFromUser(ctx context.Context, username, permission string) error {
+	defer managedUsers.invalidate(r.data.Endpoint)
 	_, err := r.data.Client.Permission.RemovePermissionFromUser(ctx, dtrack.Permission{Name: permission}, username)
+	if isPermissionUnchanged(err) {
+		return nil
+	}
 	return err
 }
 
+// isPermissionUnchanged reports whether err is Dependency-Track's answer to
+// granting a permission the user already holds or revoking one it does not:
+// 304, or 409 on some versions. Re-applies and recovery from partially
+// applied changes run into these, and treat them as success.
+func isPermissionUnchanged(err error) bool {
+	return isNotModified(err) || apiErrorStatusCode(err) == http.StatusConflict
+}
+
+// addPermissionsToUser adds each of permissions to the user. No state is
+// written when Create fails, so if adding a permission fails the permissions
+// added before it are removed again rather than left behind untracked.
+// Permissions the user held already are kept.
+func (r *ManagedUserPermissionsResource) addPermissionsToUser(ctx context.Context, username string, permissions []string, diags *diag.Diagnostics) {
+	var added []string
+	for _, permName := range permissions {
+		wasAdded, err := r.addPermissionToUser(ctx, username, permName)
+		if err == nil {
+			if wasAdded {
+				added = append(added, permName)
+			}
+			continue
+		}
+
+		diags.AddError("Client Error", fmt.Sprintf("Unable to add permission %s to user, got error: %s", permName, err))
+
+		var remaining []string
+		for _, permName := range added {
+			if err := r.removePermissionFromUser(ctx, username, permName); err != nil {
+				remaining = append(remaining, permName)
+			}
+		}
+		if len(remaining) > 0 {
+			diags.AddError(
+				"Unable to Roll Back Permissions",
+				fmt.Sprintf("The following permissions were added to user %s before the failure and could not be removed again: %s. "+
+					"Remove them manually or import the user's permissions into Terraform.", username, strings.Join(remaining, ", ")),
+			)
+		}
+		return
+	}
+}
+
+// getUserPermissions returns the permissions of the managed user, or nil if
+// there is no such user. The list of managed users is shared with the other
+// resources of the operation through managedUsers.
 func (r *ManagedUserPermissionsResource) getUserPermissions(ctx context.Context, username string) ([]string, error) {
-	users, err := fetchAllPages(ctx, r.data.Client.User.GetAllManaged)
+	users, err := managedUsers.users(ctx, r.data.Endpoint, func(ctx context.Context) ([]dtrack.ManagedUser, error) {
+		return fetchAllPages(ctx, r.data.Client.User.GetAllManaged)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -136,13 +208,17 @@ func (r *ManagedUserPermissionsResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	// Add each permission to the user
-	for _, permName := range desiredPermissions {
-		err := r.addPermissionToUser(ctx, username, permName)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add permission %s to user, got error: %s", permName, err))
-			return
-		}
+	// Permissions the user holds already are left out, so that a failure
+	// below only rolls back permissions this create added.
+	existingPermissions, err := r.getUserPermissions(ctx, username)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user permissions, got error: %s", err))
+		return
+	}
+
+	r.addPermissionsToUser(ctx, username, stringSetDifference(desiredPermissions, existingPermissions), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Read back the actual permissions from the API to ensure state consistency
@@ -153,7 +229,7 @@ func (r *ManagedUserPermissionsResource) Create(ctx context.Context, req resourc
 	}
 
 	// Convert to Set type
-	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, actualPermissions)
+	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, managedPermissions(actualPermissions, desiredPermissions, data.Exclusive.ValueBool()))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -188,8 +264,20 @@ func (r *ManagedUserPermissionsResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	// Imported resources and state from before exclusive existed have it
+	// null.
+	if data.Exclusive.IsNull() {
+		data.Exclusive = types.BoolValue(true)
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert to Set type
-	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, permissions)
+	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, managedPermissions(permissions, managed, data.Exclusive.ValueBool()))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -234,15 +322,20 @@ func (r *ManagedUserPermissionsResource) Update(ctx context.Context, req resourc
 	// Add permissions that are in desired but not in current
 	for _, permName := range desiredPermissions {
 		if !currentMap[permName] {
-			err := r.addPermissionToUser(ctx, username, permName)
-			if err != nil {
+			if _, err := r.addPermissionToUser(ctx, username, permName); err != nil {
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add permission %s to user, got error: %s", permName, err))
 				return
 			}
 		}
 	}
 
-	// Remove permissions that are in current but not in desired
+	// Remove permissions that are in current but not in desired. When
+	// switching to non-exclusive, state still holds every permission of the
+	// user, including those never granted by Terraform, so nothing is
+	// revoked.
+	if switchingToNonExclusive(state.Exclusive, plan.Exclusive) {
+		currentPermissions = nil
+	}
 	for _, permName := range currentPermissions {
 		if !desiredMap[permName] {
 			err := r.removePermissionFromUser(ctx, username, permName)
@@ -261,7 +354,7 @@ func (r *ManagedUserPermissionsResource) Update(ctx context.Context, req resourc
 	}
 
 	// Convert to Set type
-	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, actualPermissions)
+	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, managedPermissions(actualPermissions, desiredPermissions, plan.Exclusive.ValueBool()))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1,8 +1,16 @@
 package provider
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -145,3 +153,95 @@ resource "dependencytrack_managed_user_permissions" "test" {
 }
 `
 }
+
+func TestManagedUserPermissionsResource_AddPermissionsRollback(t *testing.T) {
+	testCases := map[string]struct {
+		failRemove bool
+		// heldStatus is the status returned for adding VIEW_PORTFOLIO,
+		// which the user already holds; zero means it is not held.
+		heldStatus   int
+		wantRemoved  []string
+		wantSummary  []string
+		wantInDetail string
+	}{
+		"rolled back": {
+			wantRemoved: []string{"BOM_UPLOAD", "VIEW_PORTFOLIO"},
+			wantSummary: []string{"Client Error"},
+		},
+		"already held permission kept (304)": {
+			heldStatus:  http.StatusNotModified,
+			wantRemoved: []string{"BOM_UPLOAD"},
+			wantSummary: []string{"Client Error"},
+		},
+		"already held permission kept (409)": {
+			heldStatus:  http.StatusConflict,
+			wantRemoved: []string{"BOM_UPLOAD"},
+			wantSummary: []string{"Client Error"},
+		},
+		"rollback fails": {
+			failRemove:   true,
+			wantSummary:  []string{"Client Error", "Unable to Roll Back Permissions"},
+			wantInDetail: "BOM_UPLOAD, VIEW_PORTFOLIO",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var removed []string
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/api/version" {
+					_, _ = w.Write([]byte(`{"version": "4.13.0"}`))
+					return
+				}
+
+				// Paths look like /api/v1/permission/{name}/user/{username}.
+				permission := strings.Split(r.URL.Path, "/")[4]
+
+				switch {
+				case r.Method == http.MethodPost && permission == "VIEW_PORTFOLIO" && tc.heldStatus != 0:
+					w.WriteHeader(tc.heldStatus)
+					return
+				case r.Method == http.MethodPost && permission == "PORTFOLIO_MANAGEMENT":
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				case r.Method == http.MethodDelete && tc.failRemove:
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				case r.Method == http.MethodDelete:
+					mu.Lock()
+					removed = append(removed, permission)
+					mu.Unlock()
+				}
+
+				_, _ = w.Write([]byte(`{"username": "jdoe"}`))
+			}))
+			defer srv.Close()
+
+			client, err := dtrack.NewClient(srv.URL, dtrack.WithAPIKey("test-key"))
+			if err != nil {
+				t.Fatalf("NewClient: %s", err)
+			}
+			r := &ManagedUserPermissionsResource{data: &Data{Client: client}}
+
+			var diags diag.Diagnostics
+			r.addPermissionsToUser(context.Background(), "jdoe", []string{"BOM_UPLOAD", "VIEW_PORTFOLIO", "PORTFOLIO_MANAGEMENT", "VULNERABILITY_ANALYSIS"}, &diags)
+
+			var summaries []string
+			for _, d := range diags.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			if !slices.Equal(summaries, tc.wantSummary) {
+				t.Errorf("error summaries = %v, want %v", summaries, tc.wantSummary)
+			}
+			if tc.wantInDetail != "" && !strings.Contains(diags.Errors()[len(diags.Errors())-1].Detail(), tc.wantInDetail) {
+				t.Errorf("rollback error detail = %q, want it to contain %q", diags.Errors()[len(diags.Errors())-1].Detail(), tc.wantInDetail)
+			}
+			if !slices.Equal(removed, tc.wantRemoved) {
+				t.Errorf("removed permissions = %v, want %v", removed, tc.wantRemoved)
+			}
+		})
+	}
+}
@@ -5,12 +5,18 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,9 +41,12 @@ type ManagedUserResourceModel struct {
 	Fullname            types.String `tfsdk:"fullname"`
 	Email               types.String `tfsdk:"email"`
 	Password            types.String `tfsdk:"password"`
+	PasswordWO          types.String `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64  `tfsdk:"password_wo_version"`
 	Suspended           types.Bool   `tfsdk:"suspended"`
 	ForcePasswordChange types.Bool   `tfsdk:"force_password_change"`
 	NonExpiryPassword   types.Bool   `tfsdk:"non_expiry_password"`
+	Teams               types.Set    `tfsdk:"teams"`
 }
 
 func (r *ManagedUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -72,9 +81,29 @@ func (r *ManagedUserResource) Schema(ctx context.Context, req resource.SchemaReq
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password for the user (only used during create/update)",
+				MarkdownDescription: "The password for the user. It is only sent to Dependency-Track on create and when it changes, so updating other attributes leaves the current password in place. " +
+					"The value is stored in the Terraform state; use `password_wo` to keep it out of the state.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Write-only alternative to `password` that is never stored in the Terraform state or plan. " +
+					"Since Terraform cannot tell whether a write-only value changed, it is only sent to Dependency-Track on create and when `password_wo_version` changes, " +
+					"so it must be set together with `password_wo_version`: bump the version along with the new password to rotate it. Requires Terraform 1.11 or newer.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password_wo`. Change it to send the current `password_wo` value to Dependency-Track.",
 				Optional:            true,
-				Sensitive:           true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
 			},
 			"suspended": schema.BoolAttribute{
 				MarkdownDescription: "Whether the user account is suspended",
@@ -94,6 +123,18 @@ func (r *ManagedUserResource) Schema(ctx context.Context, req resource.SchemaReq
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"teams": schema.SetAttribute{
+				MarkdownDescription: "UUIDs of the teams the user is a member of. When set, this is the complete set of the user's teams: " +
+					"memberships added outside of Terraform show up as drift and are removed on the next apply. " +
+					"When unset, team memberships are left alone and not tracked, and removing the attribute keeps the current memberships. " +
+					"If the user cannot be added to its teams when it is created, the new user is deleted again, so that the next apply starts over. " +
+					"Do not combine it with `dependencytrack_user_team_membership` resources for the same user, as they would undo each other's changes.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(uuidValidator{}),
+				},
+			},
 		},
 	}
 }
@@ -128,8 +169,17 @@ func (r *ManagedUserResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// Get password value to use for both newPassword and confirmPassword
+	// Get password value to use for both newPassword and confirmPassword.
+	// Write-only values are null in the plan and only available from config.
 	password := data.Password.ValueString()
+	if data.Password.IsNull() {
+		var passwordWO types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		password = passwordWO.ValueString()
+	}
 
 	// Create managed user via API
 	user := dtrack.ManagedUser{
@@ -144,6 +194,7 @@ func (r *ManagedUserResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	createdUser, err := r.data.Client.User.CreateManaged(ctx, user)
+	managedUsers.invalidate(r.data.Endpoint)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create managed user, got error: %s", err))
 		return
@@ -160,6 +211,17 @@ func (r *ManagedUserResource) Create(ctx context.Context, req resource.CreateReq
 
 	// Keep password from plan in state (it's already marked as sensitive)
 
+	if !data.Teams.IsNull() {
+		resp.Diagnostics.Append(r.reconcileTeams(ctx, createdUser.Username, data.Teams, nil)...)
+		if resp.Diagnostics.HasError() {
+			// The user exists, so it is tracked even though its teams are
+			// not; the next apply retries them.
+			data.Teams = types.SetNull(types.StringType)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "created a managed user resource")
 
 	// Save data into Terraform state
@@ -195,22 +257,49 @@ func (r *ManagedUserResource) Read(ctx context.Context, req resource.ReadRequest
 	data.ForcePasswordChange = types.BoolValue(user.ForcePasswordChange)
 	data.NonExpiryPassword = types.BoolValue(user.NonExpiryPassword)
 
+	// Teams are only tracked when configured.
+	if !data.Teams.IsNull() {
+		var prior []string
+		resp.Diagnostics.Append(data.Teams.ElementsAs(ctx, &prior, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		teams, diags := types.SetValueFrom(ctx, types.StringType, uuidsInPriorSpelling(userTeamUUIDs(user.Teams), prior))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Teams = teams
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ManagedUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ManagedUserResourceModel
+	var data, state ManagedUserResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Get password value to use for both newPassword and confirmPassword
-	password := data.Password.ValueString()
+	// Only send the password when it changes, so that updating other
+	// attributes (e.g. toggling suspended) does not reset it. A write-only
+	// password is never in state, so password_wo_version signals its changes.
+	password := managedUserPasswordChange(data.Password, state.Password)
+	if !data.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		var passwordWO types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		password = passwordWO.ValueString()
+	}
 
 	// Update user via API
 	user := dtrack.ManagedUser{
@@ -239,6 +328,24 @@ func (r *ManagedUserResource) Update(ctx context.Context, req resource.UpdateReq
 
 	// Keep password from plan in state (it's already marked as sensitive)
 
+	// The current memberships are read rather than taken from state, which
+	// is null when teams was not configured before.
+	if !data.Teams.IsNull() {
+		current, found, err := r.getManagedUser(ctx, data.Username.ValueString())
+		if err != nil || !found {
+			if err == nil {
+				err = fmt.Errorf("user not found: %s", data.Username.ValueString())
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read managed user teams, got error: %s", err))
+			return
+		}
+
+		resp.Diagnostics.Append(r.reconcileTeams(ctx, data.Username.ValueString(), data.Teams, userTeamUUIDs(current.Teams))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -259,6 +366,7 @@ func (r *ManagedUserResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 
 	err := r.data.Client.User.DeleteManaged(ctx, user)
+	managedUsers.invalidate(r.data.Endpoint)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete managed user, got error: %s", err))
 		return
@@ -278,6 +386,64 @@ func (r *ManagedUserResource) ImportState(ctx context.Context, req resource.Impo
 
 // Helper methods for API calls
 
+// managedUserPasswordChange returns the password to send as newPassword and
+// confirmPassword in an update, or "" (left out of the request) when the
+// planned password is unset, unknown or unchanged from state.
+func managedUserPasswordChange(plan, state types.String) string {
+	if plan.IsNull() || plan.IsUnknown() || plan.ValueString() == "" || plan.Equal(state) {
+		return ""
+	}
+	return plan.ValueString()
+}
+
+// reconcileTeams adds the user to the teams in desired that are not in
+// current and removes it from the teams in current that are not in desired.
+func (r *ManagedUserResource) reconcileTeams(ctx context.Context, username string, desired types.Set, current []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desiredTeams []string
+	diags.Append(desired.ElementsAs(ctx, &desiredTeams, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	desiredTeams, current = canonicalUUIDs(desiredTeams), canonicalUUIDs(current)
+
+	for _, team := range stringSetDifference(desiredTeams, current) {
+		teamUUID, err := uuid.Parse(team)
+		if err != nil {
+			diags.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+			return diags
+		}
+		if _, err := r.data.Client.User.AddTeamToUser(ctx, username, teamUUID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add user to team %s, got error: %s", team, err))
+			return diags
+		}
+	}
+
+	for _, team := range stringSetDifference(current, desiredTeams) {
+		teamUUID, err := uuid.Parse(team)
+		if err != nil {
+			diags.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+			return diags
+		}
+		if _, err := r.data.Client.User.RemoveTeamFromUser(ctx, username, teamUUID); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove user from team %s, got error: %s", team, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// userTeamUUIDs returns the UUIDs of teams.
+func userTeamUUIDs(teams []dtrack.Team) []string {
+	uuids := make([]string, 0, len(teams))
+	for _, team := range teams {
+		uuids = append(uuids, team.UUID.String())
+	}
+	return uuids
+}
+
 // getManagedUser lists all managed users and returns the one matching
 // username. The managed user endpoint has no get-by-name variant, so a missing
 // user is reported via found=false (not an error): the list call itself
@@ -2,12 +2,17 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // TestAccManagedUserResource tests the managed_user resource.
@@ -146,3 +151,259 @@ resource "dependencytrack_managed_user" "test" {
 }
 `, username, fullname, email, password, flag)
 }
+
+func TestAccManagedUserResource_WriteOnlyPassword(t *testing.T) {
+	username := "tf_acc_wo_" + randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedUserResourceConfigWriteOnly(username, "P@ssw0rd123", 1, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("password_wo"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("password"),
+						knownvalue.Null(),
+					),
+				},
+			},
+			// Suspending the user does not need the password
+			{
+				Config: testAccManagedUserResourceConfigWriteOnly(username, "P@ssw0rd123", 1, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("suspended"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Rotate the password
+			{
+				Config: testAccManagedUserResourceConfigWriteOnly(username, "N3wP@ssw0rd", 2, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("password_wo_version"),
+						knownvalue.Int64Exact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("password_wo"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccManagedUserResourceConfigWriteOnly(username, password string, version int, suspended bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_managed_user" "test" {
+  username            = %[1]q
+  fullname            = "Write-Only Password Test User"
+  password_wo         = %[2]q
+  password_wo_version = %[3]d
+  suspended           = %[4]t
+}
+`, username, password, version, suspended)
+}
+
+func TestManagedUserPasswordChange(t *testing.T) {
+	testCases := map[string]struct {
+		plan, state types.String
+		want        string
+	}{
+		"unchanged": {
+			plan:  types.StringValue("P@ssw0rd123"),
+			state: types.StringValue("P@ssw0rd123"),
+			want:  "",
+		},
+		"changed": {
+			plan:  types.StringValue("N3wP@ssw0rd"),
+			state: types.StringValue("P@ssw0rd123"),
+			want:  "N3wP@ssw0rd",
+		},
+		"set after import": {
+			plan:  types.StringValue("P@ssw0rd123"),
+			state: types.StringNull(),
+			want:  "P@ssw0rd123",
+		},
+		"unset": {
+			plan:  types.StringNull(),
+			state: types.StringValue("P@ssw0rd123"),
+			want:  "",
+		},
+		"empty": {
+			plan:  types.StringValue(""),
+			state: types.StringNull(),
+			want:  "",
+		},
+		"unknown": {
+			plan:  types.StringUnknown(),
+			state: types.StringValue("P@ssw0rd123"),
+			want:  "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := managedUserPasswordChange(tc.plan, tc.state); got != tc.want {
+				t.Errorf("managedUserPasswordChange(%s, %s) = %q, want %q", tc.plan, tc.state, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAccManagedUserResource_WriteOnlyPasswordRequiresVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_managed_user" "test" {
+  username    = "tf_acc_wo_no_version"
+  fullname    = "Write-Only Password Test User"
+  password_wo = "P@ssw0rd123"
+}
+`,
+				ExpectError: regexp.MustCompile(`password_wo_version`),
+			},
+		},
+	})
+}
+
+// TestAccManagedUserResource_Teams tests that the teams attribute adds and
+// removes memberships, and that dropping it leaves them alone.
+func TestAccManagedUserResource_Teams(t *testing.T) {
+	suffix := randomSuffix()
+	username := "teams_user_" + suffix
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedUserResourceConfigTeams(username, suffix, `[dependencytrack_team.a.id]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("teams"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+			{
+				Config: testAccManagedUserResourceConfigTeams(username, suffix, `[dependencytrack_team.b.id]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("teams"),
+						knownvalue.SetSizeExact(1),
+					),
+					statecheck.CompareValuePairs(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("teams").AtSliceIndex(0),
+						"dependencytrack_team.b",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			{
+				Config: testAccManagedUserResourceConfigTeams(username, suffix, `[dependencytrack_team.a.id, dependencytrack_team.b.id]`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("teams"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+			},
+			{
+				Config: testAccManagedUserResourceConfigTeams(username, suffix, `null`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("teams"),
+						knownvalue.Null(),
+					),
+				},
+			},
+		},
+	})
+}
+
+// TestAccManagedUserResource_TeamsFailure tests that a user whose teams
+// cannot be set on create is deleted again, so that a later apply can create
+// it from scratch instead of failing because the username is taken.
+func TestAccManagedUserResource_TeamsFailure(t *testing.T) {
+	username := "teams_failure_user_" + randomSuffix()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccManagedUserResourceConfigTeamsOnly(username, fmt.Sprintf("[%q]", uuid.NewString())),
+				ExpectError: regexp.MustCompile(`Unable to add user to team`),
+			},
+			{
+				Config: testAccManagedUserResourceConfigTeamsOnly(username, "null"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_managed_user.test",
+						tfjsonpath.New("username"),
+						knownvalue.StringExact(username),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccManagedUserResourceConfigTeamsOnly(username, teams string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_managed_user" "test" {
+  username = %[1]q
+  fullname = "Teams Failure Test User"
+  email    = "teams-failure@example.com"
+  password = "P@ssw0rd123"
+  teams    = %[2]s
+}
+`, username, teams)
+}
+
+func testAccManagedUserResourceConfigTeams(username, suffix, teams string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team" "a" {
+  name = "test-user-teams-a-%[2]s"
+}
+
+resource "dependencytrack_team" "b" {
+  name = "test-user-teams-b-%[2]s"
+}
+
+resource "dependencytrack_managed_user" "test" {
+  username = %[1]q
+  fullname = "Teams Test User"
+  email    = "teams@example.com"
+  password = "P@ssw0rd123"
+  teams    = %[3]s
+}
+`, username, suffix, teams)
+}
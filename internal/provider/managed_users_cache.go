@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// managedUsers is the process-wide cache of managed user lists used by
+// dependencytrack_managed_user_permissions.
+var managedUsers = newManagedUserCache()
+
+// managedUserCache reuses the list of managed users across the reads of one
+// Terraform operation, keyed by endpoint. Dependency-Track has no endpoint
+// returning a single managed user with its permissions, so without the cache
+// every managed_user_permissions resource fetches the complete list on
+// refresh. Entries are dropped whenever managed users or their permissions
+// are written through the provider.
+type managedUserCache struct {
+	mu      sync.Mutex
+	entries map[string][]dtrack.ManagedUser
+}
+
+func newManagedUserCache() *managedUserCache {
+	return &managedUserCache{
+		entries: make(map[string][]dtrack.ManagedUser),
+	}
+}
+
+// users returns the cached managed users of endpoint, calling fetch to obtain
+// and cache them when there are none. Fetches are serialized, so concurrent
+// reads share one fetch. Errors are not cached.
+func (c *managedUserCache) users(ctx context.Context, endpoint string, fetch func(context.Context) ([]dtrack.ManagedUser, error)) ([]dtrack.ManagedUser, error) {
+	key := strings.TrimRight(endpoint, "/")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if users, ok := c.entries[key]; ok {
+		return users, nil
+	}
+
+	users, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.entries[key] = users
+	return users, nil
+}
+
+// invalidate drops the cached managed users of endpoint.
+func (c *managedUserCache) invalidate(endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, strings.TrimRight(endpoint, "/"))
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+func TestManagedUserCache(t *testing.T) {
+	c := newManagedUserCache()
+
+	fetches := 0
+	fetch := func(context.Context) ([]dtrack.ManagedUser, error) {
+		fetches++
+		return []dtrack.ManagedUser{{Username: "admin"}}, nil
+	}
+
+	if _, err := c.users(context.Background(), "https://dt.example.com/", fetch); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Same endpoint (modulo trailing slash) reuses the list
+	users, _ := c.users(context.Background(), "https://dt.example.com", fetch)
+	if fetches != 1 || len(users) != 1 || users[0].Username != "admin" {
+		t.Fatalf("expected the cached list, got %v after %d fetches", users, fetches)
+	}
+
+	// A different endpoint fetches its own list
+	_, _ = c.users(context.Background(), "https://other.example.com", fetch)
+	if fetches != 2 {
+		t.Fatalf("expected a fetch for another endpoint, got %d fetches", fetches)
+	}
+
+	// Invalidation forces a new fetch
+	c.invalidate("https://dt.example.com/")
+	_, _ = c.users(context.Background(), "https://dt.example.com", fetch)
+	if fetches != 3 {
+		t.Fatalf("expected a fetch after invalidation, got %d fetches", fetches)
+	}
+}
+
+func TestManagedUserCacheErrorNotCached(t *testing.T) {
+	c := newManagedUserCache()
+
+	wantErr := errors.New("boom")
+	if _, err := c.users(context.Background(), "https://dt.example.com", func(context.Context) ([]dtrack.ManagedUser, error) {
+		return nil, wantErr
+	}); !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	}
+
+	fetches := 0
+	_, err := c.users(context.Background(), "https://dt.example.com", func(context.Context) ([]dtrack.ManagedUser, error) {
+		fetches++
+		return nil, nil
+	})
+	if err != nil || fetches != 1 {
+		t.Fatalf("expected a new fetch after an error, got %d fetches, error %v", fetches, err)
+	}
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ManagedUsersDataSource{}
+
+func NewManagedUsersDataSource() datasource.DataSource {
+	return &ManagedUsersDataSource{}
+}
+
+// ManagedUsersDataSource defines the data source implementation.
+type ManagedUsersDataSource struct {
+	data *Data
+}
+
+// ManagedUsersDataSourceModel describes the data source data model.
+type ManagedUsersDataSourceModel struct {
+	ID    types.String           `tfsdk:"id"`
+	Users []ManagedUserDataModel `tfsdk:"users"`
+}
+
+// ManagedUserDataModel describes an individual managed user.
+type ManagedUserDataModel struct {
+	Username    types.String `tfsdk:"username"`
+	Fullname    types.String `tfsdk:"fullname"`
+	Email       types.String `tfsdk:"email"`
+	Suspended   types.Bool   `tfsdk:"suspended"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (d *ManagedUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_users"
+}
+
+func (d *ManagedUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves all managed (local) users of Dependency-Track, e.g. to audit accounts. " +
+			"LDAP and OIDC users are not included.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this data source result (always `managed_users`).",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of managed users",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The username of the user",
+						},
+						"fullname": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The full name of the user",
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The email address of the user",
+						},
+						"suspended": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether the user account is suspended",
+						},
+						"permissions": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Names of the permissions granted to the user directly (not through teams)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ManagedUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *ManagedUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ManagedUsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := fetchAllPages(ctx, d.data.Client.User.GetAllManaged)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read managed users, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("managed_users")
+	data.Users = make([]ManagedUserDataModel, 0, len(users))
+	for _, user := range users {
+		permissions := make([]string, 0, len(user.Permissions))
+		for _, perm := range user.Permissions {
+			permissions = append(permissions, perm.Name)
+		}
+
+		permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, permissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Users = append(data.Users, ManagedUserDataModel{
+			Username:    types.StringValue(user.Username),
+			Fullname:    types.StringValue(user.Fullname),
+			Email:       types.StringValue(user.Email),
+			Suspended:   types.BoolValue(user.Suspended),
+			Permissions: permissionsSet,
+		})
+	}
+
+	tflog.Trace(ctx, "read a managed users data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccManagedUsersDataSource(t *testing.T) {
+	username := "tf_acc_users_ds_" + randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManagedUsersDataSourceConfig(username),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_managed_users.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("managed_users"),
+					),
+					statecheck.ExpectKnownOutputValue("test_user", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"username":    knownvalue.StringExact(username),
+						"fullname":    knownvalue.StringExact("Users Data Source Test User"),
+						"email":       knownvalue.StringExact(username + "@example.com"),
+						"suspended":   knownvalue.Bool(false),
+						"permissions": knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("VIEW_PORTFOLIO")}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccManagedUsersDataSourceConfig(username string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_managed_user" "test" {
+  username = %[1]q
+  fullname = "Users Data Source Test User"
+  email    = "%[1]s@example.com"
+  password = "TestPassword123!"
+}
+
+resource "dependencytrack_managed_user_permissions" "test" {
+  user        = dependencytrack_managed_user.test.id
+  permissions = ["VIEW_PORTFOLIO"]
+}
+
+data "dependencytrack_managed_users" "test" {
+  depends_on = [
+    dependencytrack_managed_user_permissions.test
+  ]
+}
+
+output "test_user" {
+  value = one([
+    for user in data.dependencytrack_managed_users.test.users : user
+    if user.username == dependencytrack_managed_user.test.username
+  ])
+}
+`, username)
+}
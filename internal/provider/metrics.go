@@ -8,6 +8,8 @@ import (
 )
 
 // Timing knobs for currentMetricsWithRefresh, overridable in unit tests.
+// metricsRefreshPollTimeout is also the default read timeout of the metrics
+// data sources.
 var (
 	metricsRefreshPollInterval = 2 * time.Second
 	metricsRefreshPollTimeout  = 60 * time.Second
@@ -22,6 +24,10 @@ var (
 // error so the caller can fall back to zero values. Dependency-Track v5
 // synthesizes a zeroed metrics object instead of an empty body, so the
 // refresh path is never taken there.
+//
+// The poll stops at ctx's deadline when it has one, so data sources can bound
+// it with their read timeout; without a deadline metricsRefreshPollTimeout
+// applies.
 func currentMetricsWithRefresh[T any](
 	ctx context.Context,
 	latest func(context.Context) (T, error),
@@ -41,7 +47,16 @@ func currentMetricsWithRefresh[T any](
 	}
 
 	deadline := time.Now().Add(metricsRefreshPollTimeout)
+	if d, ok := ctx.Deadline(); ok {
+		deadline = d
+	}
 	for {
+		// Give up while there is still time left for the caller to fall
+		// back, rather than letting the next poll run into the deadline.
+		if time.Until(deadline) < metricsRefreshPollInterval {
+			return m, false, nil
+		}
+
 		select {
 		case <-ctx.Done():
 			return m, false, ctx.Err()
@@ -55,9 +70,5 @@ func currentMetricsWithRefresh[T any](
 		if !errors.Is(err, io.EOF) {
 			return m, false, err
 		}
-
-		if time.Now().After(deadline) {
-			return m, false, nil
-		}
 	}
 }
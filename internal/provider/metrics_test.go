@@ -119,3 +119,27 @@ func TestCurrentMetricsWithRefreshContextCanceled(t *testing.T) {
 		t.Fatalf("got error %v, want context.Canceled", err)
 	}
 }
+
+func TestCurrentMetricsWithRefreshContextDeadline(t *testing.T) {
+	fastMetricsPolling(t)
+	// A deadline shorter than the default poll timeout wins.
+	metricsRefreshPollTimeout = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, found, err := currentMetricsWithRefresh(ctx,
+		func(context.Context) (int, error) { return 0, io.EOF },
+		func(context.Context) error { return nil },
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if found {
+		t.Fatal("found should be false when metrics never appear")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("polling took %s, want it bounded by the context deadline", elapsed)
+	}
+}
@@ -44,7 +44,9 @@ func (d *NotificationPublisherDataSource) Metadata(ctx context.Context, req data
 
 func (d *NotificationPublisherDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches a notification publisher from Dependency-Track by UUID or name. Either `uuid` or `name` must be specified.",
+		MarkdownDescription: "Fetches a notification publisher from Dependency-Track by UUID, name, or publisher class. " +
+			"Exactly one lookup is performed, in that order of precedence: `uuid`, then `name`, then `publisher_class`. " +
+			"Looking up by `publisher_class` resolves built-in publishers (e.g. Slack, Webhook, Email) without hardcoding their UUIDs.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -52,19 +54,19 @@ func (d *NotificationPublisherDataSource) Schema(ctx context.Context, req dataso
 				Computed:            true,
 			},
 			"uuid": schema.StringAttribute{
-				MarkdownDescription: "The UUID of the notification publisher. Either `uuid` or `name` must be specified.",
+				MarkdownDescription: "The UUID of the notification publisher. One of `uuid`, `name`, or `publisher_class` must be specified.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.AtLeastOneOf(path.MatchRoot("name")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("name"), path.MatchRoot("publisher_class")),
 				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the notification publisher. Either `uuid` or `name` must be specified.",
+				MarkdownDescription: "The name of the notification publisher. One of `uuid`, `name`, or `publisher_class` must be specified.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.String{
-					stringvalidator.AtLeastOneOf(path.MatchRoot("uuid")),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("uuid"), path.MatchRoot("publisher_class")),
 				},
 			},
 			"description": schema.StringAttribute{
@@ -72,8 +74,14 @@ func (d *NotificationPublisherDataSource) Schema(ctx context.Context, req dataso
 				Computed:            true,
 			},
 			"publisher_class": schema.StringAttribute{
-				MarkdownDescription: "The publisher implementation: a fully qualified class name on Dependency-Track v4, or an extension name on v5",
-				Computed:            true,
+				MarkdownDescription: "The publisher implementation: a fully qualified class name on Dependency-Track v4, or an extension name on v5. " +
+					"One of `uuid`, `name`, or `publisher_class` must be specified. When several publishers share the class, the default (built-in) publisher is returned; " +
+					"if none of them is the default, the lookup fails and `name` must be used instead.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("uuid"), path.MatchRoot("name")),
+				},
 			},
 			"template": schema.StringAttribute{
 				MarkdownDescription: "The template content for the notification",
@@ -120,11 +128,12 @@ func (d *NotificationPublisherDataSource) Read(ctx context.Context, req datasour
 
 	hasUUID := !data.UUID.IsNull() && data.UUID.ValueString() != ""
 	hasName := !data.Name.IsNull() && data.Name.ValueString() != ""
+	hasClass := !data.PublisherClass.IsNull() && data.PublisherClass.ValueString() != ""
 
-	if !hasUUID && !hasName {
+	if !hasUUID && !hasName && !hasClass {
 		resp.Diagnostics.AddError(
 			"Missing Search Criteria",
-			"Either 'uuid' or 'name' must be specified to look up a notification publisher.",
+			"One of 'uuid', 'name', or 'publisher_class' must be specified to look up a notification publisher.",
 		)
 		return
 	}
@@ -161,7 +170,7 @@ func (d *NotificationPublisherDataSource) Read(ctx context.Context, req datasour
 		}
 
 		tflog.Trace(ctx, "read notification publisher data source by UUID")
-	} else {
+	} else if hasName {
 		searchName := data.Name.ValueString()
 
 		for _, p := range publishers {
@@ -180,6 +189,17 @@ func (d *NotificationPublisherDataSource) Read(ctx context.Context, req datasour
 		}
 
 		tflog.Trace(ctx, "read notification publisher data source by name")
+	} else {
+		searchClass := data.PublisherClass.ValueString()
+
+		p, err := findPublisherByClass(publishers, searchClass)
+		if err != nil {
+			resp.Diagnostics.AddError("Notification Publisher Not Found", err.Error())
+			return
+		}
+		publisher = &p
+
+		tflog.Trace(ctx, "read notification publisher data source by publisher class")
 	}
 
 	data.ID = types.StringValue(publisher.UUID.String())
@@ -199,3 +219,35 @@ func (d *NotificationPublisherDataSource) Read(ctx context.Context, req datasour
 func (d *NotificationPublisherDataSource) getAllPublishers(ctx context.Context) ([]NotificationPublisher, error) {
 	return apiGetAllPages[NotificationPublisher](ctx, d.data.API(), "/api/v1/notification/publisher", nil)
 }
+
+// findPublisherByClass returns the publisher whose class (v4 publisherClass or
+// v5 extensionName) equals class. Custom publishers may reuse a built-in
+// publisher's class, so when several match, the single default publisher
+// among them wins; anything else is reported as an error.
+func findPublisherByClass(publishers []NotificationPublisher, class string) (NotificationPublisher, error) {
+	var matches []NotificationPublisher
+	for _, p := range publishers {
+		if p.class() == class {
+			matches = append(matches, p)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return NotificationPublisher{}, fmt.Errorf("no notification publisher found with publisher class: %s", class)
+	case 1:
+		return matches[0], nil
+	}
+
+	var defaults []NotificationPublisher
+	for _, p := range matches {
+		if p.DefaultPublisher {
+			defaults = append(defaults, p)
+		}
+	}
+	if len(defaults) == 1 {
+		return defaults[0], nil
+	}
+
+	return NotificationPublisher{}, fmt.Errorf("%d notification publishers share the publisher class %s and none is unambiguously the default; look the publisher up by name instead", len(matches), class)
+}
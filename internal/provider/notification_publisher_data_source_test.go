@@ -115,6 +115,84 @@ func TestAccNotificationPublisherDataSource_BothUUIDAndName(t *testing.T) {
 	})
 }
 
+func TestAccNotificationPublisherDataSource_ByPublisherClass(t *testing.T) {
+	publisherClass := testAccPublisherClass(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A custom publisher sharing the class must not shadow the built-in one
+			{
+				Config: testAccNotificationPublisherDataSourceConfigByPublisherClass(publisherClass),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_notification_publisher.by_class",
+						tfjsonpath.New("publisher_class"),
+						knownvalue.StringExact(publisherClass),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_notification_publisher.by_class",
+						tfjsonpath.New("default_publisher"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_notification_publisher.by_class",
+						tfjsonpath.New("uuid"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccNotificationPublisherDataSourceConfigByPublisherClass(publisherClass string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_notification_publisher" "test" {
+  name               = "Test Publisher for Class Lookup"
+  publisher_class    = %[1]q
+  template_mime_type = "text/plain"
+}
+
+data "dependencytrack_notification_publisher" "by_class" {
+  publisher_class = %[1]q
+  depends_on      = [dependencytrack_notification_publisher.test]
+}
+`, publisherClass)
+}
+
+func TestFindPublisherByClass(t *testing.T) {
+	builtin := NotificationPublisher{Name: "Outbound Webhook", PublisherClass: "org.example.WebhookPublisher", DefaultPublisher: true}
+	custom := NotificationPublisher{Name: "Custom Webhook", PublisherClass: "org.example.WebhookPublisher"}
+	v5 := NotificationPublisher{Name: "Slack", ExtensionName: "slack", DefaultPublisher: true}
+
+	tests := []struct {
+		name       string
+		publishers []NotificationPublisher
+		class      string
+		wantName   string
+		wantErr    bool
+	}{
+		{"single match", []NotificationPublisher{custom, v5}, "org.example.WebhookPublisher", "Custom Webhook", false},
+		{"v5 extension name", []NotificationPublisher{builtin, v5}, "slack", "Slack", false},
+		{"default wins over custom", []NotificationPublisher{custom, builtin}, "org.example.WebhookPublisher", "Outbound Webhook", false},
+		{"ambiguous customs", []NotificationPublisher{custom, custom}, "org.example.WebhookPublisher", "", true},
+		{"no match", []NotificationPublisher{builtin}, "email", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findPublisherByClass(tt.publishers, tt.class)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("findPublisherByClass() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.Name != tt.wantName {
+				t.Errorf("findPublisherByClass() = %q, want %q", got.Name, tt.wantName)
+			}
+		})
+	}
+}
+
 func testAccNotificationPublisherDataSourceConfigByUUID(publisherClass string) string {
 	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
 resource "dependencytrack_notification_publisher" "test" {
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 
 	"github.com/google/uuid"
@@ -21,6 +23,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NotificationPublisherResource{}
 var _ resource.ResourceWithImportState = &NotificationPublisherResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationPublisherResource{}
 
 func NewNotificationPublisherResource() resource.Resource {
 	return &NotificationPublisherResource{}
@@ -110,6 +113,51 @@ func warnOnPublisherClassVersionMismatch(diags *diag.Diagnostics, isV5 bool, cla
 	}
 }
 
+// addDefaultPublisherError appends the diagnostic returned when Terraform tries
+// to update or delete one of Dependency-Track's built-in (default) publishers.
+// The server rejects both operations with an unhelpful 4xx, so the provider
+// refuses up front instead of surfacing the raw API error.
+func addDefaultPublisherError(diags *diag.Diagnostics, name, action string) {
+	diags.AddError(
+		"Default Notification Publisher Cannot Be Modified",
+		fmt.Sprintf("The notification publisher %q is one of Dependency-Track's built-in default publishers, which cannot be %s. "+
+			"Look it up with the dependencytrack_notification_publisher data source instead of managing it as a resource, "+
+			"and remove it from Terraform state (e.g. with a removed block or terraform state rm) to stop managing it.", name, action),
+	)
+}
+
+// Pebble template constructs, as used by Dependency-Track notification
+// templates: {# comments #}, {% tags %} and {{ expressions }}.
+var (
+	pebbleCommentRegex    = regexp.MustCompile(`(?s)\{#.*?#\}`)
+	pebbleTagRegex        = regexp.MustCompile(`(?s)\{%.*?%\}`)
+	pebbleExpressionRegex = regexp.MustCompile(`(?s)\{\{.*?\}\}`)
+)
+
+// isJSONMimeType reports whether mimeType denotes a JSON document, i.e.
+// application/json or a structured +json suffix type such as
+// application/vnd.api+json, ignoring any parameters.
+func isJSONMimeType(mimeType string) bool {
+	mediaType := strings.ToLower(strings.TrimSpace(strings.SplitN(mimeType, ";", 2)[0]))
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// validatePebbleJSONTemplate checks that a Pebble template meant to render a
+// JSON document is well-formed JSON once its templating is set aside:
+// comments and {% tags %} are removed and every {{ expression }} is replaced
+// by 0, which is valid both inside a string literal and as a bare value (e.g.
+// {{ subject | json_encode }}). Templates whose control flow only produces
+// valid JSON when rendered (e.g. commas emitted inside {% if %} blocks) may
+// still be reported, so callers should treat an error as a warning.
+func validatePebbleJSONTemplate(template string) error {
+	stripped := pebbleCommentRegex.ReplaceAllString(template, "")
+	stripped = pebbleTagRegex.ReplaceAllString(stripped, "")
+	stripped = pebbleExpressionRegex.ReplaceAllString(stripped, "0")
+
+	var v any
+	return json.Unmarshal([]byte(stripped), &v)
+}
+
 func (r *NotificationPublisherResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_notification_publisher"
 }
@@ -147,7 +195,7 @@ func (r *NotificationPublisherResource) Schema(ctx context.Context, req resource
 				Required:            true,
 			},
 			"template": schema.StringAttribute{
-				MarkdownDescription: "The template content for the notification",
+				MarkdownDescription: "The Pebble template content for the notification. When `template_mime_type` is a JSON type, the provider warns at plan time if the template does not parse as JSON once its Pebble placeholders are set aside.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -156,7 +204,7 @@ func (r *NotificationPublisherResource) Schema(ctx context.Context, req resource
 				Required:            true,
 			},
 			"default_publisher": schema.BoolAttribute{
-				MarkdownDescription: "Whether this is a default publisher (read-only, cannot be modified or deleted)",
+				MarkdownDescription: "Whether this is a built-in default publisher. Default publishers cannot be modified or deleted; the provider rejects updates and deletes of such a publisher with an explanatory error",
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
@@ -164,6 +212,37 @@ func (r *NotificationPublisherResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *NotificationPublisherResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationPublisherResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Template.IsNull() || data.Template.IsUnknown() || data.TemplateMimeType.IsUnknown() {
+		return
+	}
+
+	if !isJSONMimeType(data.TemplateMimeType.ValueString()) {
+		return
+	}
+
+	// Dependency-Track accepts any template and only fails when it renders a
+	// notification, so surface obviously broken JSON templates at plan time.
+	if err := validatePebbleJSONTemplate(data.Template.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("template"),
+			"Template Is Not Valid JSON",
+			fmt.Sprintf("template_mime_type is %q, but the template does not parse as JSON once its Pebble placeholders are set aside: %s. "+
+				"Dependency-Track accepts such templates but silently fails to publish notifications rendered from them. "+
+				"If the template only yields valid JSON after rendering (for example, because of commas inside {%% if %%} blocks), this warning can be ignored.",
+				data.TemplateMimeType.ValueString(), err),
+		)
+	}
+}
+
 func (r *NotificationPublisherResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -266,14 +345,20 @@ func (r *NotificationPublisherResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *NotificationPublisherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data NotificationPublisherResourceModel
+	var data, state NotificationPublisherResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if state.DefaultPublisher.ValueBool() {
+		addDefaultPublisherError(&resp.Diagnostics, state.Name.ValueString(), "modified")
+		return
+	}
+
 	publisherUUID, err := uuid.Parse(data.UUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse UUID: %s", err))
@@ -326,6 +411,11 @@ func (r *NotificationPublisherResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	if data.DefaultPublisher.ValueBool() {
+		addDefaultPublisherError(&resp.Diagnostics, data.Name.ValueString(), "deleted")
+		return
+	}
+
 	publisherUUID, err := uuid.Parse(data.UUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse UUID: %s", err))
@@ -198,3 +198,44 @@ func testAccDeleteNotificationPublisherByName(t *testing.T, name string) {
 
 	t.Fatalf("notification publisher %q not found for external delete", name)
 }
+
+func TestValidatePebbleJSONTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{"plain json", `{"content": "test"}`, false},
+		{"expression inside string", `{"level": "{{ notification.level | escape(strategy="json") }}"}`, false},
+		{"bare expression value", `{"subject": {{ subject | json_encode }}}`, false},
+		{"tags and comments", "{# webhook #}{\"a\": 1{% if x %}, \"b\": 2{% endif %}}", false},
+		{"multiline expression", "{\"a\": {{\n  notification.content\n}}}", false},
+		{"missing brace", `{"content": "test"`, true},
+		{"not json", `Hello {{ notification.title }}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePebbleJSONTemplate(tt.template)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePebbleJSONTemplate(%q) error = %v, wantErr %v", tt.template, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsJSONMimeType(t *testing.T) {
+	tests := map[string]bool{
+		"application/json":                true,
+		"Application/JSON; charset=utf-8": true,
+		"application/vnd.api+json":        true,
+		"text/plain":                      false,
+		"application/xml":                 false,
+	}
+
+	for mimeType, want := range tests {
+		if got := isJSONMimeType(mimeType); got != want {
+			t.Errorf("isJSONMimeType(%q) = %v, want %v", mimeType, got, want)
+		}
+	}
+}
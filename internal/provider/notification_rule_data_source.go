@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NotificationRuleDataSource{}
+
+func NewNotificationRuleDataSource() datasource.DataSource {
+	return &NotificationRuleDataSource{}
+}
+
+// NotificationRuleDataSource defines the data source implementation.
+type NotificationRuleDataSource struct {
+	data *Data
+}
+
+// NotificationRuleDataSourceModel describes the data source data model.
+type NotificationRuleDataSourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	UUID              types.String `tfsdk:"uuid"`
+	Name              types.String `tfsdk:"name"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	Scope             types.String `tfsdk:"scope"`
+	NotificationLevel types.String `tfsdk:"notification_level"`
+	NotifyOn          types.Set    `tfsdk:"notify_on"`
+	Publisher         types.String `tfsdk:"publisher"`
+}
+
+func (d *NotificationRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_rule"
+}
+
+func (d *NotificationRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves information about a Dependency-Track notification rule by name. " +
+			"Useful for attaching projects, teams or tags to a rule that is managed outside of Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the notification rule (same as UUID)",
+			},
+			"uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the notification rule",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the notification rule. It is an error if more than one rule has this name.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the notification rule is enabled",
+			},
+			"scope": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The scope of the notification rule (PORTFOLIO or SYSTEM)",
+			},
+			"notification_level": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The notification level (INFORMATIONAL, WARNING, or ERROR)",
+			},
+			"notify_on": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of notification groups the rule triggers on",
+			},
+			"publisher": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the notification publisher used by the rule",
+			},
+		},
+	}
+}
+
+func (d *NotificationRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *NotificationRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NotificationRuleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rule endpoint has no lookup by name (or by UUID), so list them all.
+	rules, err := apiGetAllPages[NotificationRule](ctx, d.data.API(), "/api/v1/notification/rule", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list notification rules, got error: %s", err))
+		return
+	}
+
+	rule, err := findNotificationRuleByName(rules, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Notification Rule Lookup Failed", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(rule.UUID.String())
+	data.UUID = types.StringValue(rule.UUID.String())
+	data.Name = types.StringValue(rule.Name)
+	data.Enabled = types.BoolValue(rule.Enabled)
+	data.Scope = types.StringValue(rule.Scope)
+	data.NotificationLevel = types.StringValue(rule.NotificationLevel)
+	data.Publisher = types.StringValue(rule.Publisher.UUID.String())
+
+	notifyOn, diags := types.SetValueFrom(ctx, types.StringType, append([]string{}, rule.NotifyOn...))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.NotifyOn = notifyOn
+
+	tflog.Trace(ctx, "read a notification rule data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findNotificationRuleByName returns the single rule named name. Rule names
+// are not unique in Dependency-Track, so an ambiguous match is an error.
+func findNotificationRuleByName(rules []NotificationRule, name string) (NotificationRule, error) {
+	var matches []NotificationRule
+	for _, r := range rules {
+		if r.Name == name {
+			matches = append(matches, r)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return NotificationRule{}, fmt.Errorf("no notification rule found with name: %s", name)
+	case 1:
+		return matches[0], nil
+	default:
+		uuids := make([]string, 0, len(matches))
+		for _, r := range matches {
+			uuids = append(uuids, r.UUID.String())
+		}
+		return NotificationRule{}, fmt.Errorf("found %d notification rules named %q (%s); rename them so the name is unique", len(matches), name, strings.Join(uuids, ", "))
+	}
+}
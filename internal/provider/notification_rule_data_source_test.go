@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNotificationRuleDataSource(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationRuleDataSourceConfig(suffix, testAccPublisherClass(t)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_notification_rule.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Test Notification Rule Data Source "+suffix),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_notification_rule.test",
+						tfjsonpath.New("scope"),
+						knownvalue.StringExact("PORTFOLIO"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_notification_rule.test",
+						tfjsonpath.New("notify_on"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("NEW_VULNERABILITY"),
+							knownvalue.StringExact("NEW_VULNERABLE_DEPENDENCY"),
+						}),
+					),
+					statecheck.CompareValuePairs(
+						"data.dependencytrack_notification_rule.test",
+						tfjsonpath.New("uuid"),
+						"dependencytrack_notification_rule.test",
+						tfjsonpath.New("uuid"),
+						compare.ValuesSame(),
+					),
+					statecheck.CompareValuePairs(
+						"data.dependencytrack_notification_rule.test",
+						tfjsonpath.New("publisher"),
+						"dependencytrack_notification_publisher.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccNotificationRuleDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+data "dependencytrack_notification_rule" "test" {
+  name = "Nonexistent Notification Rule %s"
+}
+`, randomSuffix()),
+				ExpectError: regexp.MustCompile(`no notification rule found with name`),
+			},
+		},
+	})
+}
+
+func testAccNotificationRuleDataSourceConfig(suffix, publisherClass string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_notification_publisher" "test" {
+  name               = "Test Publisher for Rule Data Source %s"
+  publisher_class    = %q
+  template_mime_type = "text/plain"
+}
+
+resource "dependencytrack_notification_rule" "test" {
+  name      = "Test Notification Rule Data Source %s"
+  scope     = "PORTFOLIO"
+  publisher = dependencytrack_notification_publisher.test.id
+
+  notify_on = [
+    "NEW_VULNERABILITY",
+    "NEW_VULNERABLE_DEPENDENCY"
+  ]
+}
+
+data "dependencytrack_notification_rule" "test" {
+  name = dependencytrack_notification_rule.test.name
+}
+`, suffix, publisherClass, suffix)
+}
+
+func TestFindNotificationRuleByName(t *testing.T) {
+	a := NotificationRule{UUID: uuid.New(), Name: "alpha"}
+	b := NotificationRule{UUID: uuid.New(), Name: "beta"}
+	dup := NotificationRule{UUID: uuid.New(), Name: "beta"}
+
+	tests := []struct {
+		name    string
+		rules   []NotificationRule
+		search  string
+		want    uuid.UUID
+		wantErr string
+	}{
+		{name: "single match", rules: []NotificationRule{a, b}, search: "alpha", want: a.UUID},
+		{name: "no match", rules: []NotificationRule{a, b}, search: "gamma", wantErr: "no notification rule found"},
+		{name: "empty list", rules: nil, search: "alpha", wantErr: "no notification rule found"},
+		{name: "ambiguous", rules: []NotificationRule{a, b, dup}, search: "beta", wantErr: "found 2 notification rules"},
+		{name: "case sensitive", rules: []NotificationRule{a}, search: "Alpha", wantErr: "no notification rule found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findNotificationRuleByName(tt.rules, tt.search)
+			if tt.wantErr != "" {
+				if err == nil || !regexp.MustCompile(regexp.QuoteMeta(tt.wantErr)).MatchString(err.Error()) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.UUID != tt.want {
+				t.Errorf("got UUID %s, want %s", got.UUID, tt.want)
+			}
+		})
+	}
+}
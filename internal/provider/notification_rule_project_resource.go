@@ -18,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NotificationRuleProjectResource{}
 var _ resource.ResourceWithImportState = &NotificationRuleProjectResource{}
+var _ resource.ResourceWithModifyPlan = &NotificationRuleProjectResource{}
 
 func NewNotificationRuleProjectResource() resource.Resource {
 	return &NotificationRuleProjectResource{}
@@ -41,7 +42,8 @@ func (r *NotificationRuleProjectResource) Metadata(ctx context.Context, req reso
 
 func (r *NotificationRuleProjectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Associates a project with a notification rule. This is only valid for notification rules with PORTFOLIO scope.",
+		MarkdownDescription: "Associates a project with a notification rule. This is only valid for notification rules with PORTFOLIO scope: " +
+			"Dependency-Track accepts projects on SYSTEM-scope rules but ignores them, and planning such an association produces a warning.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -87,6 +89,48 @@ func (r *NotificationRuleProjectResource) Configure(ctx context.Context, req res
 	r.data = providerData
 }
 
+func (r *NotificationRuleProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.data == nil {
+		return
+	}
+
+	var plan NotificationRuleProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Rule.IsUnknown() {
+		return
+	}
+
+	// Only check associations that are created or moved to another rule.
+	if !req.State.Raw.IsNull() {
+		var state NotificationRuleProjectResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Rule.Equal(plan.Rule) {
+			return
+		}
+	}
+
+	ruleUUID, err := uuid.Parse(plan.Rule.ValueString())
+	if err != nil {
+		return
+	}
+
+	// Dependency-Track accepts projects on SYSTEM-scope rules but ignores
+	// them. The scope check is best effort and never fails the plan.
+	scope, err := r.ruleScope(ctx, ruleUUID)
+	if err != nil {
+		tflog.Debug(ctx, "unable to read notification rule scope", map[string]any{"rule": ruleUUID.String(), "error": err.Error()})
+		return
+	}
+	if scope == "SYSTEM" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("rule"),
+			"Project On System Rule",
+			fmt.Sprintf("Notification rule %s has SYSTEM scope, so Dependency-Track ignores its projects and the association has no effect. "+
+				"Projects only limit PORTFOLIO-scope rules.", ruleUUID),
+		)
+	}
+}
+
 func (r *NotificationRuleProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data NotificationRuleProjectResourceModel
 
@@ -203,27 +247,60 @@ func (r *NotificationRuleProjectResource) Delete(ctx context.Context, req resour
 
 func (r *NotificationRuleProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import using format: rule/project
-	rule, project, err := parseCompositeID(req.ID, "rule", "project")
+	ruleUUID, projectUUID, err := parseCompositeUUIDID(req.ID, "rule", "project")
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Unable to parse import ID: %s\nExpected format: rule/project", err))
 		return
 	}
 
+	// Fail here rather than importing an association that does not exist,
+	// which would otherwise only surface as a confusing plan.
+	exists, err := r.projectAssociationExists(ctx, ruleUUID, projectUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check notification rule project association, got error: %s", err))
+		return
+	}
+	if !exists {
+		resp.Diagnostics.AddError(
+			"Association Not Found",
+			fmt.Sprintf("Project %s is not associated with notification rule %s, so there is nothing to import.", projectUUID, ruleUUID),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule"), rule)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule"), ruleUUID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), projectUUID.String())...)
 }
 
 // Helper methods
 
 func (r *NotificationRuleProjectResource) addProjectToRule(ctx context.Context, ruleUUID, projectUUID uuid.UUID) error {
 	apiPath := fmt.Sprintf("/api/v1/notification/rule/%s/project/%s", ruleUUID, projectUUID)
-	return r.data.API().Do(ctx, http.MethodPost, apiPath, nil, nil)
+	// Associations added in parallel can collide on the rule and fail with a
+	// transient 409; see withConflictRetry.
+	return r.data.API().Do(withConflictRetry(ctx), http.MethodPost, apiPath, nil, nil)
 }
 
 func (r *NotificationRuleProjectResource) removeProjectFromRule(ctx context.Context, ruleUUID, projectUUID uuid.UUID) error {
 	apiPath := fmt.Sprintf("/api/v1/notification/rule/%s/project/%s", ruleUUID, projectUUID)
-	return r.data.API().Do(ctx, http.MethodDelete, apiPath, nil, nil)
+	return r.data.API().Do(withConflictRetry(ctx), http.MethodDelete, apiPath, nil, nil)
+}
+
+// ruleScope returns the scope of the notification rule.
+func (r *NotificationRuleProjectResource) ruleScope(ctx context.Context, ruleUUID uuid.UUID) (string, error) {
+	rules, err := apiGetAllPages[NotificationRule](ctx, r.data.API(), "/api/v1/notification/rule", nil)
+	if err != nil {
+		return "", err
+	}
+
+	for _, rule := range rules {
+		if rule.UUID == ruleUUID {
+			return rule.Scope, nil
+		}
+	}
+
+	return "", fmt.Errorf("notification rule not found: %s", ruleUUID)
 }
 
 func (r *NotificationRuleProjectResource) projectAssociationExists(ctx context.Context, ruleUUID, projectUUID uuid.UUID) (bool, error) {
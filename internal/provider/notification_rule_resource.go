@@ -2,10 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -13,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +26,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NotificationRuleResource{}
 var _ resource.ResourceWithImportState = &NotificationRuleResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationRuleResource{}
+var _ resource.ResourceWithModifyPlan = &NotificationRuleResource{}
 
 func NewNotificationRuleResource() resource.Resource {
 	return &NotificationRuleResource{}
@@ -44,7 +52,10 @@ type NotificationRuleResourceModel struct {
 	Teams                types.Set    `tfsdk:"teams"`
 	NotifyOn             types.Set    `tfsdk:"notify_on"`
 	Publisher            types.String `tfsdk:"publisher"`
+	PublisherClass       types.String `tfsdk:"publisher_class"`
 	PublisherConfig      types.String `tfsdk:"publisher_config"`
+	TriggerType          types.String `tfsdk:"trigger_type"`
+	ScheduleCron         types.String `tfsdk:"schedule_cron"`
 }
 
 // NotificationRule represents the API model.
@@ -58,9 +69,22 @@ type NotificationRule struct {
 	NotificationLevel    string                    `json:"notificationLevel,omitempty"`
 	Projects             []NotificationRuleProject `json:"projects,omitempty"`
 	Teams                []NotificationRuleTeam    `json:"teams,omitempty"`
+	Tags                 []NotificationRuleTag     `json:"tags,omitempty"`
 	NotifyOn             []string                  `json:"notifyOn,omitempty"`
 	Publisher            NotificationRulePublisher `json:"publisher"`
 	PublisherConfig      string                    `json:"publisherConfig,omitempty"`
+	TriggerType          string                    `json:"triggerType,omitempty"`
+	ScheduleCron         string                    `json:"scheduleCron,omitempty"`
+}
+
+// scheduledNotificationRuleRequest is the request body of the scheduled rule
+// create endpoint, which only takes the fields needed to create the rule; the
+// schedule and everything else are set with a follow-up update.
+type scheduledNotificationRuleRequest struct {
+	Name              string                    `json:"name"`
+	Scope             string                    `json:"scope"`
+	NotificationLevel string                    `json:"notificationLevel,omitempty"`
+	Publisher         NotificationRulePublisher `json:"publisher"`
 }
 
 type NotificationRuleProject struct {
@@ -71,8 +95,23 @@ type NotificationRuleTeam struct {
 	UUID uuid.UUID `json:"uuid"`
 }
 
+type NotificationRuleTag struct {
+	Name string `json:"name"`
+}
+
 type NotificationRulePublisher struct {
-	UUID uuid.UUID `json:"uuid"`
+	UUID           uuid.UUID `json:"uuid"`
+	PublisherClass string    `json:"publisherClass,omitempty"`
+	ExtensionName  string    `json:"extensionName,omitempty"`
+}
+
+// class mirrors NotificationPublisher.class for the publisher embedded in a
+// rule.
+func (p NotificationRulePublisher) class() string {
+	if p.ExtensionName != "" {
+		return p.ExtensionName
+	}
+	return p.PublisherClass
 }
 
 func (r *NotificationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -108,7 +147,7 @@ func (r *NotificationRuleResource) Schema(ctx context.Context, req resource.Sche
 				Computed:            true,
 			},
 			"notify_children": schema.BoolAttribute{
-				MarkdownDescription: "Whether to notify on child projects (defaults to true if not specified)",
+				MarkdownDescription: "Whether to notify on child projects of the rule's projects (defaults to true if not specified). Only applies to PORTFOLIO-scope rules; Dependency-Track ignores it for SYSTEM scope.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -143,13 +182,27 @@ func (r *NotificationRuleResource) Schema(ctx context.Context, req resource.Sche
 				ElementType:         types.StringType,
 			},
 			"publisher": schema.StringAttribute{
-				MarkdownDescription: "The UUID of the notification publisher to use",
-				Required:            true,
-			},
-			"publisher_config": schema.StringAttribute{
-				MarkdownDescription: "Publisher-specific configuration (JSON string)",
+				MarkdownDescription: "The UUID of the notification publisher to use. Exactly one of `publisher` and `publisher_class` must be set.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("publisher"), path.MatchRoot("publisher_class")),
+				},
+			},
+			"publisher_class": schema.StringAttribute{
+				MarkdownDescription: "The class of the notification publisher to use, resolved to the publisher's UUID on create and update " +
+					"(e.g. `org.dependencytrack.notification.publisher.SlackPublisher` on Dependency-Track v4, `slack` on v5). " +
+					"When several publishers share the class, the default (built-in) one is used. " +
+					"Exactly one of `publisher` and `publisher_class` must be set.",
+				Optional: true,
+				Computed: true,
+			},
+			"publisher_config": schema.StringAttribute{
+				MarkdownDescription: "Publisher-specific configuration (JSON string). The `webhook_config` and `slack_config` provider functions build it for the webhook and Slack publishers. " +
+					"Marked sensitive since it routinely contains credentials such as webhook tokens; its values are also redacted from error messages.",
+				Optional:  true,
+				Computed:  true,
+				Sensitive: true,
 				// Carry the prior value into update plans when the config is
 				// null: DT v5 populates the publisher's default config on rule
 				// create and rejects updates that omit publisherConfig when
@@ -160,10 +213,90 @@ func (r *NotificationRuleResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"trigger_type": schema.StringAttribute{
+				MarkdownDescription: "What triggers the rule: `EVENT` (the default) sends a notification for each matching event, " +
+					"`SCHEDULE` sends periodic digests on the `schedule_cron` schedule. " +
+					"Scheduled rules require Dependency-Track 4.13 or newer and notify on summary groups such as " +
+					"NEW_VULNERABILITIES_SUMMARY and NEW_POLICY_VIOLATIONS_SUMMARY. Changing this forces a new rule.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("EVENT"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("EVENT", "SCHEDULE"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_cron": schema.StringAttribute{
+				MarkdownDescription: "Five-field cron expression on which a `SCHEDULE` rule sends its digest, e.g. `0 8 * * MON-FRI`. " +
+					"Only valid when `trigger_type` is `SCHEDULE`; when unset, Dependency-Track's default schedule is kept.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					cronValidator{},
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+func (r *NotificationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationRuleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Dependency-Track silently ignores notify_children (and projects, see
+	// NotificationRuleProjectResource) on SYSTEM-scope rules. It is not an
+	// error to keep existing configurations valid.
+	if !data.NotifyChildren.IsNull() && !data.NotifyChildren.IsUnknown() && data.Scope.ValueString() == "SYSTEM" {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("notify_children"),
+			"Notify Children On System Rule",
+			"notify_children only applies to PORTFOLIO-scope rules, Dependency-Track ignores it for SYSTEM scope. Remove it or change scope to PORTFOLIO.",
+		)
+	}
+
+	if data.ScheduleCron.IsNull() || data.TriggerType.IsUnknown() {
+		return
+	}
+
+	// trigger_type defaults to EVENT, so a null value means an event rule.
+	if data.TriggerType.ValueString() != "SCHEDULE" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schedule_cron"),
+			"Schedule On Event Rule",
+			"schedule_cron can only be set when trigger_type is SCHEDULE.",
+		)
+	}
+}
+
+func (r *NotificationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	// (e.g. during validation with unknown provider configuration).
+	if req.Plan.Raw.IsNull() || r.data == nil {
+		return
+	}
+
+	var plan NotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Scheduled rules were introduced in Dependency-Track 4.13.
+	if plan.TriggerType.ValueString() == "SCHEDULE" {
+		requireServerVersion(r.data, path.Root("trigger_type"), 4, 13, &resp.Diagnostics)
+	}
+}
+
 func (r *NotificationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -191,10 +324,9 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	// Parse publisher UUID
-	publisherUUID, err := uuid.Parse(data.Publisher.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Publisher UUID", fmt.Sprintf("Unable to parse publisher UUID: %s", err))
+	publisherUUID, diags := r.resolvePublisher(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -233,6 +365,12 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 	if !data.PublisherConfig.IsNull() && !data.PublisherConfig.IsUnknown() {
 		rule.PublisherConfig = data.PublisherConfig.ValueString()
 	}
+	if !data.TriggerType.IsNull() && !data.TriggerType.IsUnknown() {
+		rule.TriggerType = data.TriggerType.ValueString()
+	}
+	if !data.ScheduleCron.IsNull() && !data.ScheduleCron.IsUnknown() {
+		rule.ScheduleCron = data.ScheduleCron.ValueString()
+	}
 
 	// Add notify_on
 	if !data.NotifyOn.IsNull() && !data.NotifyOn.IsUnknown() {
@@ -246,38 +384,14 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 
 	createdRule, err := r.createRule(ctx, rule)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification rule, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", redactPublisherConfig(fmt.Sprintf("Unable to create notification rule, got error: %s", err), rule.PublisherConfig))
 		return
 	}
 
-	// API quirk: The PUT endpoint (create) ignores several fields and uses API defaults instead:
-	// - notifyOn: always returns empty array (default: [])
-	// - enabled: always returns true (default: true)
-	// - notifyChildren: always returns true (default: true)
-	// - logSuccessfulPublish: always returns false (default: false)
-	// - publisherConfig: returns empty
-	// We need to immediately follow up with a POST (update) if any of these differ from defaults.
-	needsUpdate := false
-	if len(rule.NotifyOn) > 0 {
-		needsUpdate = true
-		createdRule.NotifyOn = rule.NotifyOn
-	}
-	if !rule.Enabled {
-		needsUpdate = true
-		createdRule.Enabled = rule.Enabled
-	}
-	if !rule.NotifyChildren {
-		needsUpdate = true
-		createdRule.NotifyChildren = rule.NotifyChildren
-	}
-	if rule.LogSuccessfulPublish {
-		needsUpdate = true
-		createdRule.LogSuccessfulPublish = rule.LogSuccessfulPublish
-	}
-	if rule.PublisherConfig != "" {
-		needsUpdate = true
-		createdRule.PublisherConfig = rule.PublisherConfig
-	}
+	// API quirk: The PUT endpoint (create) ignores several fields and uses API
+	// defaults instead (see reconcileCreatedRule). Immediately follow up with a
+	// POST (update) when any of them came back different from what we sent.
+	needsUpdate := reconcileCreatedRule(rule, &createdRule)
 
 	if needsUpdate {
 		tflog.Debug(ctx, "Following up with update to set fields ignored by PUT endpoint due to API limitation")
@@ -289,15 +403,28 @@ func (r *NotificationRuleResource) Create(ctx context.Context, req resource.Crea
 			// every subsequent apply fail with a duplicate-name error.
 			resp.Diagnostics.Append(r.updateModelFromAPI(ctx, &data, &createdRule)...)
 			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification rule fields, got error: %s", updateErr))
+			resp.Diagnostics.AddError("Client Error", redactPublisherConfig(fmt.Sprintf("Unable to update notification rule fields, got error: %s", updateErr), rule.PublisherConfig))
 			return
 		}
 		createdRule = updatedRule
 	}
 
-	// Update model with response from create/update
-	// Note: We use the create response directly instead of reading back, as the API
-	// may return empty arrays for relationships even when they are set
+	// Read the rule back so state reflects the server's view, including
+	// fields the create/update responses leave out. List reads may in turn
+	// return empty arrays and publisherConfig, so those are filled in from
+	// the responses (see mergeReadBackRule).
+	readRule, found, err := r.getRule(ctx, createdRule.UUID)
+	switch {
+	case err != nil:
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Back Notification Rule",
+			fmt.Sprintf("The notification rule was created, but reading it back failed, so its state is taken from the create response: %s. "+
+				"The next refresh reconciles any difference.", err),
+		)
+	case found:
+		createdRule = mergeReadBackRule(createdRule, readRule)
+	}
+
 	resp.Diagnostics.Append(r.updateModelFromAPI(ctx, &data, &createdRule)...)
 
 	tflog.Trace(ctx, "created a notification rule resource")
@@ -352,10 +479,9 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	// Parse publisher UUID
-	publisherUUID, err := uuid.Parse(data.Publisher.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Publisher UUID", fmt.Sprintf("Unable to parse publisher UUID: %s", err))
+	publisherUUID, diags := r.resolvePublisher(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -395,6 +521,12 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 	if !data.PublisherConfig.IsNull() && !data.PublisherConfig.IsUnknown() {
 		rule.PublisherConfig = data.PublisherConfig.ValueString()
 	}
+	if !data.TriggerType.IsNull() && !data.TriggerType.IsUnknown() {
+		rule.TriggerType = data.TriggerType.ValueString()
+	}
+	if !data.ScheduleCron.IsNull() && !data.ScheduleCron.IsUnknown() {
+		rule.ScheduleCron = data.ScheduleCron.ValueString()
+	}
 
 	// Add notify_on
 	if !data.NotifyOn.IsNull() && !data.NotifyOn.IsUnknown() {
@@ -408,7 +540,7 @@ func (r *NotificationRuleResource) Update(ctx context.Context, req resource.Upda
 
 	updatedRule, err := r.updateRule(ctx, rule)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification rule, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", redactPublisherConfig(fmt.Sprintf("Unable to update notification rule, got error: %s", err), rule.PublisherConfig))
 		return
 	}
 
@@ -481,7 +613,24 @@ func (r *NotificationRuleResource) updateModelFromAPI(ctx context.Context, model
 		model.NotificationLevel = types.StringNull()
 	}
 
+	// Servers older than 4.13 only have event rules and omit triggerType.
+	if rule.TriggerType != "" {
+		model.TriggerType = types.StringValue(rule.TriggerType)
+	} else {
+		model.TriggerType = types.StringValue("EVENT")
+	}
+	if rule.ScheduleCron != "" {
+		model.ScheduleCron = types.StringValue(rule.ScheduleCron)
+	} else if model.ScheduleCron.IsUnknown() {
+		model.ScheduleCron = types.StringNull()
+	}
+
 	model.Publisher = types.StringValue(rule.Publisher.UUID.String())
+	if class := rule.Publisher.class(); class != "" {
+		model.PublisherClass = types.StringValue(class)
+	} else if model.PublisherClass.IsUnknown() {
+		model.PublisherClass = types.StringNull()
+	}
 
 	switch {
 	case rule.PublisherConfig != "":
@@ -536,18 +685,142 @@ func (r *NotificationRuleResource) updateModelFromAPI(ctx context.Context, model
 	return diags
 }
 
-// API methods
+// resolvePublisher returns the UUID of the publisher the rule should use:
+// either the configured publisher UUID, or the publisher found by listing
+// publishers and matching publisher_class.
+func (r *NotificationRuleResource) resolvePublisher(ctx context.Context, data NotificationRuleResourceModel) (uuid.UUID, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-// createRule creates a new notification rule using PUT /api/v1/notification/rule.
-// Note: This endpoint has known limitations - it ignores several fields and uses API defaults:
+	if !data.Publisher.IsNull() && !data.Publisher.IsUnknown() {
+		publisherUUID, err := uuid.Parse(data.Publisher.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("publisher"), "Invalid Publisher UUID", fmt.Sprintf("Unable to parse publisher UUID: %s", err))
+		}
+		return publisherUUID, diags
+	}
+
+	publishers, err := apiGetAllPages[NotificationPublisher](ctx, r.data.API(), "/api/v1/notification/publisher", nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list notification publishers, got error: %s", err))
+		return uuid.Nil, diags
+	}
+
+	publisher, err := findPublisherByClass(publishers, data.PublisherClass.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("publisher_class"), "Notification Publisher Not Found", err.Error())
+		return uuid.Nil, diags
+	}
+	return publisher.UUID, nil
+}
+
+// reconcileCreatedRule copies onto created every field of desired that the
+// create (PUT) endpoint is known to drop or replace with a default, and
+// reports whether any of them differed, i.e. whether a follow-up update is
+// needed for the configuration to actually persist:
 //   - notifyOn: always returns empty array (default: [])
 //   - enabled: always returns true (default: true)
 //   - notifyChildren: always returns true (default: true)
 //   - logSuccessfulPublish: always returns false (default: false)
+//   - publisherConfig: dropped for some publishers (e.g. webhook) and not
+//     echoed at all by DT >= 4.14
+//   - notificationLevel: may come back as the INFORMATIONAL default
+//   - scheduleCron: not accepted by the scheduled rule create endpoint
+//
+// publisherConfig is compared as JSON, so a server that merely re-serialized
+// the value does not trigger an update.
+func reconcileCreatedRule(desired NotificationRule, created *NotificationRule) bool {
+	needsUpdate := false
+
+	if len(desired.NotifyOn) > 0 && !slices.Equal(sortedStrings(desired.NotifyOn), sortedStrings(created.NotifyOn)) {
+		needsUpdate = true
+		created.NotifyOn = desired.NotifyOn
+	}
+	if desired.Enabled != created.Enabled {
+		needsUpdate = true
+		created.Enabled = desired.Enabled
+	}
+	if desired.NotifyChildren != created.NotifyChildren {
+		needsUpdate = true
+		created.NotifyChildren = desired.NotifyChildren
+	}
+	if desired.LogSuccessfulPublish != created.LogSuccessfulPublish {
+		needsUpdate = true
+		created.LogSuccessfulPublish = desired.LogSuccessfulPublish
+	}
+	if desired.PublisherConfig != "" &&
+		(created.PublisherConfig == "" || !jsonStringsEquivalent(desired.PublisherConfig, created.PublisherConfig)) {
+		needsUpdate = true
+		created.PublisherConfig = desired.PublisherConfig
+	}
+	if desired.NotificationLevel != "" && desired.NotificationLevel != created.NotificationLevel {
+		needsUpdate = true
+		created.NotificationLevel = desired.NotificationLevel
+	}
+	if desired.ScheduleCron != "" && desired.ScheduleCron != created.ScheduleCron {
+		needsUpdate = true
+		created.ScheduleCron = desired.ScheduleCron
+	}
+
+	return needsUpdate
+}
+
+// mergeReadBackRule returns read, the rule as listed after a create, with the
+// fields list reads are known to leave empty filled in from written, the
+// create or follow-up update response.
+func mergeReadBackRule(written, read NotificationRule) NotificationRule {
+	merged := read
+	if len(merged.NotifyOn) == 0 {
+		merged.NotifyOn = written.NotifyOn
+	}
+	if len(merged.Projects) == 0 {
+		merged.Projects = written.Projects
+	}
+	if len(merged.Teams) == 0 {
+		merged.Teams = written.Teams
+	}
+	if len(merged.Tags) == 0 {
+		merged.Tags = written.Tags
+	}
+	if merged.PublisherConfig == "" {
+		merged.PublisherConfig = written.PublisherConfig
+	}
+	if merged.ScheduleCron == "" {
+		merged.ScheduleCron = written.ScheduleCron
+	}
+	return merged
+}
+
+// sortedStrings returns a sorted copy of s, for order-insensitive comparison.
+func sortedStrings(s []string) []string {
+	sorted := slices.Clone(s)
+	slices.Sort(sorted)
+	return sorted
+}
+
+// API methods
+
+// createRule creates a new notification rule using PUT /api/v1/notification/rule.
+// Note: This endpoint has known limitations - it ignores several fields and
+// uses API defaults instead (see reconcileCreatedRule). Callers should follow
+// up with updateRule() if any of these fields need non-default values.
 //
-// Callers should follow up with updateRule() if any of these fields need non-default values.
+// Scheduled rules are created with PUT /api/v1/notification/rule/scheduled
+// instead, which takes only the name, scope, level and publisher.
 func (r *NotificationRuleResource) createRule(ctx context.Context, rule NotificationRule) (NotificationRule, error) {
 	var result NotificationRule
+	if rule.TriggerType == "SCHEDULE" {
+		scheduled := scheduledNotificationRuleRequest{
+			Name:              rule.Name,
+			Scope:             rule.Scope,
+			NotificationLevel: rule.NotificationLevel,
+			Publisher:         rule.Publisher,
+		}
+		if err := r.data.API().Do(ctx, http.MethodPut, "/api/v1/notification/rule/scheduled", scheduled, &result); err != nil {
+			return NotificationRule{}, err
+		}
+		return result, nil
+	}
+
 	if err := r.data.API().Do(ctx, http.MethodPut, "/api/v1/notification/rule", rule, &result); err != nil {
 		return NotificationRule{}, err
 	}
@@ -597,6 +870,72 @@ func (r *NotificationRuleResource) deleteRule(ctx context.Context, ruleUUID uuid
 		return nil
 	}
 
-	// DELETE requires the rule object in the body with all required fields
-	return r.data.API().Do(ctx, http.MethodDelete, "/api/v1/notification/rule", rule, nil)
+	// DELETE requires the rule object in the body with all required fields.
+	// Error responses may echo it, so its publisher config is redacted.
+	if err := r.data.API().Do(ctx, http.MethodDelete, "/api/v1/notification/rule", rule, nil); err != nil {
+		return errors.New(redactPublisherConfig(err.Error(), rule.PublisherConfig))
+	}
+	return nil
+}
+
+// sensitivePublisherConfigKeys are the substrings of publisher config keys
+// whose values are always redacted, however short they are.
+var sensitivePublisherConfigKeys = []string{"token", "secret", "password", "key", "auth", "url", "destination"}
+
+// redactPublisherConfig replaces the string values of publisherConfig in msg.
+// Error responses can echo parts of the submitted rule, and publisher configs
+// routinely carry credentials (e.g. tokens embedded in webhook URLs), so
+// diagnostics must not repeat them. Values under keys that name a credential
+// or URL are always redacted; short values under other keys, such as "true",
+// are left alone since they would garble the message.
+func redactPublisherConfig(msg, publisherConfig string) string {
+	if publisherConfig == "" {
+		return msg
+	}
+
+	var config any
+	if err := json.Unmarshal([]byte(publisherConfig), &config); err != nil {
+		// Not JSON; redact the raw value as a whole.
+		return strings.ReplaceAll(msg, publisherConfig, "(sensitive value)")
+	}
+
+	var values []string
+	var collect func(v any, sensitive bool)
+	collect = func(v any, sensitive bool) {
+		switch v := v.(type) {
+		case string:
+			if v != "" && (sensitive || len(v) >= 8) {
+				values = append(values, v)
+			}
+		case map[string]any:
+			for key, item := range v {
+				collect(item, sensitive || isSensitivePublisherConfigKey(key))
+			}
+		case []any:
+			for _, item := range v {
+				collect(item, sensitive)
+			}
+		}
+	}
+	collect(config, false)
+
+	// Longest first, so a value containing another is replaced whole.
+	slices.SortFunc(values, func(a, b string) int { return len(b) - len(a) })
+	for _, value := range values {
+		msg = strings.ReplaceAll(msg, value, "(sensitive value)")
+		// Error bodies may quote values as JSON, escaping e.g. quotes and &.
+		if escaped, err := json.Marshal(value); err == nil {
+			msg = strings.ReplaceAll(msg, strings.Trim(string(escaped), `"`), "(sensitive value)")
+		}
+	}
+	return msg
+}
+
+// isSensitivePublisherConfigKey reports whether the values of the publisher
+// config key are redacted regardless of their length.
+func isSensitivePublisherConfigKey(key string) bool {
+	key = strings.ToLower(key)
+	return slices.ContainsFunc(sensitivePublisherConfigKeys, func(s string) bool {
+		return strings.Contains(key, s)
+	})
 }
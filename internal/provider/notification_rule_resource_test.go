@@ -1,12 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"math/rand/v2"
+	"regexp"
+	"slices"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -199,6 +207,98 @@ resource "dependencytrack_notification_rule" "test_minimal" {
 `, suffix, publisherClass)
 }
 
+func TestAccNotificationRuleResource_Scheduled(t *testing.T) {
+	if !testAccServerVersion(t).AtLeast(4, 13) {
+		t.Skip("scheduled notification rules require Dependency-Track 4.13 or newer")
+	}
+	suffix := randomSuffix()
+	publisherClass := testAccPublisherClass(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationRuleResourceConfigScheduled(suffix, publisherClass, "0 8 * * MON-FRI"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_notification_rule.test",
+						tfjsonpath.New("trigger_type"),
+						knownvalue.StringExact("SCHEDULE"),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_notification_rule.test",
+						tfjsonpath.New("schedule_cron"),
+						knownvalue.StringExact("0 8 * * MON-FRI"),
+					),
+				},
+			},
+			{
+				ResourceName:      "dependencytrack_notification_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNotificationRuleResourceConfigScheduled(suffix, publisherClass, "0 6 * * *"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_notification_rule.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_notification_rule.test",
+						tfjsonpath.New("schedule_cron"),
+						knownvalue.StringExact("0 6 * * *"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccNotificationRuleResource_ScheduleOnEventRule(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_notification_rule" "test" {
+  name          = "Event Rule With Schedule"
+  scope         = "PORTFOLIO"
+  publisher     = "00000000-0000-0000-0000-000000000000"
+  notify_on     = ["NEW_VULNERABILITY"]
+  schedule_cron = "0 8 * * *"
+}
+`,
+				ExpectError: regexp.MustCompile(`Schedule On Event Rule`),
+			},
+		},
+	})
+}
+
+func testAccNotificationRuleResourceConfigScheduled(suffix, publisherClass, cron string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_notification_publisher" "test" {
+  name               = "Test Publisher Scheduled %s"
+  publisher_class    = %q
+  template_mime_type = "application/json"
+}
+
+resource "dependencytrack_notification_rule" "test" {
+  name          = "Scheduled Notification Rule %s"
+  scope         = "PORTFOLIO"
+  publisher     = dependencytrack_notification_publisher.test.id
+  trigger_type  = "SCHEDULE"
+  schedule_cron = %q
+
+  notify_on = [
+    "NEW_VULNERABILITIES_SUMMARY"
+  ]
+}
+`, suffix, publisherClass, suffix, cron)
+}
+
 func TestAccNotificationRuleResource_WithTeams(t *testing.T) {
 	suffix := randomSuffix()
 	resource.Test(t, resource.TestCase{
@@ -249,6 +349,12 @@ func TestAccNotificationRuleResource_WithPublisherConfig(t *testing.T) {
 						tfjsonpath.New("publisher_config"),
 						knownvalue.StringExact(publisherConfig),
 					),
+					// Non-default notification level must persist on first apply
+					statecheck.ExpectKnownValue(
+						"dependencytrack_notification_rule.test_publisher_config",
+						tfjsonpath.New("notification_level"),
+						knownvalue.StringExact("ERROR"),
+					),
 				},
 			},
 			// ImportState testing
@@ -272,6 +378,86 @@ func TestAccNotificationRuleResource_WithPublisherConfig(t *testing.T) {
 	})
 }
 
+// TestAccNotificationRuleResource_PublisherClass references the built-in
+// webhook publisher by class and then switches to referencing it by UUID,
+// which must not change the rule.
+func TestAccNotificationRuleResource_PublisherClass(t *testing.T) {
+	suffix := randomSuffix()
+	publisherClass := testAccPublisherClass(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationRuleResourceConfigPublisherClass(suffix, publisherClass, fmt.Sprintf("publisher_class = %q", publisherClass)),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_notification_rule.test",
+						tfjsonpath.New("publisher_class"),
+						knownvalue.StringExact(publisherClass),
+					),
+					statecheck.CompareValuePairs(
+						"dependencytrack_notification_rule.test",
+						tfjsonpath.New("publisher"),
+						"data.dependencytrack_notification_publisher.webhook",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+				},
+			},
+			{
+				ResourceName:      "dependencytrack_notification_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Referencing the same publisher by UUID is a no-op
+			{
+				Config: testAccNotificationRuleResourceConfigPublisherClass(suffix, publisherClass, "publisher = data.dependencytrack_notification_publisher.webhook.id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_notification_rule.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccNotificationRuleResource_PublisherClassNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_notification_rule" "test" {
+  name            = "Notification Rule with Unknown Publisher Class"
+  scope           = "PORTFOLIO"
+  publisher_class = "org.example.DoesNotExist"
+  notify_on       = ["NEW_VULNERABILITY"]
+}
+`,
+				ExpectError: regexp.MustCompile(`no notification publisher found with publisher class`),
+			},
+		},
+	})
+}
+
+func testAccNotificationRuleResourceConfigPublisherClass(suffix, publisherClass, publisherAttribute string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+data "dependencytrack_notification_publisher" "webhook" {
+  publisher_class = %[2]q
+}
+
+resource "dependencytrack_notification_rule" "test" {
+  name      = "Notification Rule by Publisher Class %[1]s"
+  scope     = "PORTFOLIO"
+  notify_on = ["NEW_VULNERABILITY"]
+  %[3]s
+}
+`, suffix, publisherClass, publisherAttribute)
+}
+
 func testAccNotificationRuleResourceConfigWithPublisherConfig(publisherConfig string) string {
 	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
 data "dependencytrack_notification_publisher" "webhook" {
@@ -316,3 +502,259 @@ resource "dependencytrack_notification_rule" "test_teams" {
 }
 `, suffix, publisherClass, suffix)
 }
+
+func TestReconcileCreatedRule(t *testing.T) {
+	desired := NotificationRule{
+		Name:                 "rule",
+		Enabled:              true,
+		NotifyChildren:       true,
+		NotificationLevel:    "INFORMATIONAL",
+		NotifyOn:             []string{"NEW_VULNERABILITY", "BOM_CONSUMED"},
+		PublisherConfig:      `{"destination":"https://example.com"}`,
+		LogSuccessfulPublish: false,
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(d *NotificationRule)
+		created NotificationRule
+		want    bool
+	}{
+		{
+			name: "everything echoed",
+			created: NotificationRule{
+				Enabled:           true,
+				NotifyChildren:    true,
+				NotificationLevel: "INFORMATIONAL",
+				NotifyOn:          []string{"BOM_CONSUMED", "NEW_VULNERABILITY"},
+				PublisherConfig:   `{"destination": "https://example.com"}`,
+			},
+			want: false,
+		},
+		{
+			// A rule that leaves every server-managed field at its default
+			// is created with a single request.
+			name: "minimal rule at defaults",
+			mutate: func(d *NotificationRule) {
+				d.NotifyOn = nil
+				d.PublisherConfig = ""
+				d.NotificationLevel = ""
+			},
+			created: NotificationRule{
+				Enabled:           true,
+				NotifyChildren:    true,
+				NotificationLevel: "INFORMATIONAL",
+			},
+			want: false,
+		},
+		{
+			name: "notify_on dropped",
+			created: NotificationRule{
+				Enabled:           true,
+				NotifyChildren:    true,
+				NotificationLevel: "INFORMATIONAL",
+				PublisherConfig:   `{"destination":"https://example.com"}`,
+			},
+			want: true,
+		},
+		{
+			name: "publisher_config dropped",
+			created: NotificationRule{
+				Enabled:           true,
+				NotifyChildren:    true,
+				NotificationLevel: "INFORMATIONAL",
+				NotifyOn:          []string{"NEW_VULNERABILITY", "BOM_CONSUMED"},
+			},
+			want: true,
+		},
+		{
+			name:   "notification_level defaulted",
+			mutate: func(d *NotificationRule) { d.NotificationLevel = "ERROR" },
+			created: NotificationRule{
+				Enabled:           true,
+				NotifyChildren:    true,
+				NotificationLevel: "INFORMATIONAL",
+				NotifyOn:          []string{"NEW_VULNERABILITY", "BOM_CONSUMED"},
+				PublisherConfig:   `{"destination":"https://example.com"}`,
+			},
+			want: true,
+		},
+		{
+			name:   "schedule_cron not accepted on create",
+			mutate: func(d *NotificationRule) { d.TriggerType, d.ScheduleCron = "SCHEDULE", "0 8 * * *" },
+			created: NotificationRule{
+				Enabled:           true,
+				NotifyChildren:    true,
+				NotificationLevel: "INFORMATIONAL",
+				NotifyOn:          []string{"NEW_VULNERABILITY", "BOM_CONSUMED"},
+				PublisherConfig:   `{"destination":"https://example.com"}`,
+				TriggerType:       "SCHEDULE",
+				ScheduleCron:      "0 * * * *",
+			},
+			want: true,
+		},
+		{
+			name:   "enabled defaulted",
+			mutate: func(d *NotificationRule) { d.Enabled = false },
+			created: NotificationRule{
+				Enabled:           true,
+				NotifyChildren:    true,
+				NotificationLevel: "INFORMATIONAL",
+				NotifyOn:          []string{"NEW_VULNERABILITY", "BOM_CONSUMED"},
+				PublisherConfig:   `{"destination":"https://example.com"}`,
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := desired
+			want.NotifyOn = append([]string(nil), desired.NotifyOn...)
+			if tt.mutate != nil {
+				tt.mutate(&want)
+			}
+
+			created := tt.created
+			if got := reconcileCreatedRule(want, &created); got != tt.want {
+				t.Fatalf("reconcileCreatedRule() = %v, want %v", got, tt.want)
+			}
+			if !tt.want {
+				return
+			}
+			if created.NotificationLevel != want.NotificationLevel || created.Enabled != want.Enabled ||
+				!jsonStringsEquivalent(created.PublisherConfig, want.PublisherConfig) || len(created.NotifyOn) != len(want.NotifyOn) ||
+				created.ScheduleCron != want.ScheduleCron {
+				t.Errorf("reconcileCreatedRule() left created = %+v, want desired fields from %+v", created, want)
+			}
+		})
+	}
+}
+
+func TestMergeReadBackRule(t *testing.T) {
+	written := NotificationRule{
+		Name:            "rule",
+		Enabled:         true,
+		NotifyOn:        []string{"NEW_VULNERABILITY"},
+		PublisherConfig: `{"destination":"https://example.com"}`,
+	}
+	read := NotificationRule{
+		Name:      "rule",
+		Enabled:   true,
+		Publisher: NotificationRulePublisher{PublisherClass: "org.dependencytrack.notification.publisher.WebhookPublisher"},
+	}
+
+	got := mergeReadBackRule(written, read)
+
+	if got.Publisher.PublisherClass != read.Publisher.PublisherClass {
+		t.Errorf("publisher class = %q, want the read-back %q", got.Publisher.PublisherClass, read.Publisher.PublisherClass)
+	}
+	if !slices.Equal(got.NotifyOn, written.NotifyOn) {
+		t.Errorf("notifyOn = %v, want %v from the create response", got.NotifyOn, written.NotifyOn)
+	}
+	if got.PublisherConfig != written.PublisherConfig {
+		t.Errorf("publisherConfig = %q, want %q from the create response", got.PublisherConfig, written.PublisherConfig)
+	}
+
+	read.NotifyOn = []string{"BOM_CONSUMED"}
+	if got := mergeReadBackRule(written, read); !slices.Equal(got.NotifyOn, read.NotifyOn) {
+		t.Errorf("notifyOn = %v, want the non-empty read-back %v", got.NotifyOn, read.NotifyOn)
+	}
+}
+
+func TestRedactPublisherConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    string
+		config string
+		want   string
+	}{
+		{
+			name:   "no config",
+			msg:    "Unable to create notification rule, got error: 400",
+			config: "",
+			want:   "Unable to create notification rule, got error: 400",
+		},
+		{
+			name:   "destination redacted",
+			msg:    `got error: invalid destination https://hooks.slack.com/services/T000/B000/XXXX`,
+			config: `{"destination":"https://hooks.slack.com/services/T000/B000/XXXX"}`,
+			want:   `got error: invalid destination (sensitive value)`,
+		},
+		{
+			name:   "nested values and JSON escaping",
+			msg:    `got error: {"token":"a&b-secret-token","headers":["Bearer abcdefgh"]}`,
+			config: `{"auth":{"token":"a&b-secret-token"},"headers":["Bearer abcdefgh"],"enabled":"true"}`,
+			want:   `got error: {"token":"(sensitive value)","headers":["(sensitive value)"]}`,
+		},
+		{
+			name:   "short values kept",
+			msg:    `got error: unknown field "mode": push`,
+			config: `{"mode":"push"}`,
+			want:   `got error: unknown field "mode": push`,
+		},
+		{
+			name:   "short values of sensitive keys redacted",
+			msg:    `got error: invalid token abc123 for channel #ops`,
+			config: `{"auth":{"token":"abc123"},"channel":"#ops"}`,
+			want:   `got error: invalid token (sensitive value) for channel #ops`,
+		},
+		{
+			name:   "not JSON",
+			msg:    `got error: cannot parse not-json-secret`,
+			config: `not-json-secret`,
+			want:   `got error: cannot parse (sensitive value)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactPublisherConfig(tt.msg, tt.config); got != tt.want {
+				t.Errorf("redactPublisherConfig() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNotificationRuleResourceValidateConfigNotifyChildren(t *testing.T) {
+	ctx := context.Background()
+	r := &NotificationRuleResource{}
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		name           string
+		scope          string
+		notifyChildren tftypes.Value
+		wantWarning    bool
+	}{
+		{name: "system scope with notify_children", scope: "SYSTEM", notifyChildren: tftypes.NewValue(tftypes.Bool, false), wantWarning: true},
+		{name: "system scope without notify_children", scope: "SYSTEM", notifyChildren: tftypes.NewValue(tftypes.Bool, nil)},
+		{name: "portfolio scope with notify_children", scope: "PORTFOLIO", notifyChildren: tftypes.NewValue(tftypes.Bool, true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, typ := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(typ, nil)
+			}
+			values["scope"] = tftypes.NewValue(tftypes.String, tt.scope)
+			values["notify_children"] = tt.notifyChildren
+
+			resp := &fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("got warning %t, want %t: %v", got, tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -135,29 +134,15 @@ func (r *NotificationRuleTagResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	tag := strings.ToLower(data.Tag.ValueString())
-
-	// The tagged-rules listing of a nonexistent tag is an empty list (not an
-	// error) on both DT v4 and v5, so a deleted tag also falls into the
-	// not-found path below.
-	rules, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
-		return r.data.Client.Tag.GetNotificationRules(ctx, tag, po, dtrack.SortOptions{})
-	})
+	exists, err := r.tagAssociationExists(ctx, ruleUUID, data.Tag.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tagged notification rules, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check notification rule tag association, got error: %s", err))
 		return
 	}
 
-	found := false
-	for i := range rules {
-		if rules[i].UUID == ruleUUID {
-			found = true
-			break
-		}
-	}
-
-	if !found {
-		// Tag is not assigned to the notification rule anymore, remove from state
+	if !exists {
+		// Tag is not assigned to the notification rule anymore (or the rule
+		// itself is gone), remove from state
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -226,3 +211,33 @@ func (r *NotificationRuleTagResource) ImportState(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), tag)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("notification_rule"), ruleUUID.String())...)
 }
+
+// tagAssociationExists reports whether the notification rule's tags field
+// contains the named tag. The rule endpoint has no get-by-uuid variant, so the
+// rule is located in the full listing; a missing rule reports false.
+func (r *NotificationRuleTagResource) tagAssociationExists(ctx context.Context, ruleUUID uuid.UUID, tag string) (bool, error) {
+	rules, err := apiGetAllPages[NotificationRule](ctx, r.data.API(), "/api/v1/notification/rule", nil)
+	if err != nil {
+		return false, err
+	}
+
+	for _, rule := range rules {
+		if rule.UUID == ruleUUID {
+			return notificationRuleHasTag(rule, tag), nil
+		}
+	}
+
+	return false, nil
+}
+
+// notificationRuleHasTag reports whether the rule carries the named tag.
+// Dependency-Track lowercases tag names on write, so the comparison is
+// case-insensitive.
+func notificationRuleHasTag(rule NotificationRule, tag string) bool {
+	for _, t := range rule.Tags {
+		if strings.EqualFold(t.Name, tag) {
+			return true
+		}
+	}
+	return false
+}
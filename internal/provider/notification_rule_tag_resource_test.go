@@ -77,3 +77,27 @@ resource "dependencytrack_notification_rule_tag" "test" {
 }
 `, suffix, suffix, publisherClass, suffix)
 }
+
+func TestNotificationRuleHasTag(t *testing.T) {
+	rule := NotificationRule{Tags: []NotificationRuleTag{{Name: "prod"}, {Name: "team-a"}}}
+
+	tests := []struct {
+		name string
+		rule NotificationRule
+		tag  string
+		want bool
+	}{
+		{name: "present", rule: rule, tag: "prod", want: true},
+		{name: "mixed case", rule: rule, tag: "Team-A", want: true},
+		{name: "absent", rule: rule, tag: "staging", want: false},
+		{name: "no tags", rule: NotificationRule{}, tag: "prod", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := notificationRuleHasTag(tt.rule, tt.tag); got != tt.want {
+				t.Errorf("notificationRuleHasTag(%q) = %v, want %v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
@@ -203,27 +203,44 @@ func (r *NotificationRuleTeamResource) Delete(ctx context.Context, req resource.
 
 func (r *NotificationRuleTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import using format: rule/team
-	rule, team, err := parseCompositeID(req.ID, "rule", "team")
+	ruleUUID, teamUUID, err := parseCompositeUUIDID(req.ID, "rule", "team")
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Unable to parse import ID: %s\nExpected format: rule/team", err))
 		return
 	}
 
+	// Fail here rather than importing an association that does not exist,
+	// which would otherwise only surface as a confusing plan.
+	exists, err := r.teamAssociationExists(ctx, ruleUUID, teamUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check notification rule team association, got error: %s", err))
+		return
+	}
+	if !exists {
+		resp.Diagnostics.AddError(
+			"Association Not Found",
+			fmt.Sprintf("Team %s is not associated with notification rule %s, so there is nothing to import.", teamUUID, ruleUUID),
+		)
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule"), rule)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), team)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rule"), ruleUUID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), teamUUID.String())...)
 }
 
 // Helper methods
 
 func (r *NotificationRuleTeamResource) addTeamToRule(ctx context.Context, ruleUUID, teamUUID uuid.UUID) error {
 	apiPath := fmt.Sprintf("/api/v1/notification/rule/%s/team/%s", ruleUUID, teamUUID)
-	return r.data.API().Do(ctx, http.MethodPost, apiPath, nil, nil)
+	// Associations added in parallel can collide on the rule and fail with a
+	// transient 409; see withConflictRetry.
+	return r.data.API().Do(withConflictRetry(ctx), http.MethodPost, apiPath, nil, nil)
 }
 
 func (r *NotificationRuleTeamResource) removeTeamFromRule(ctx context.Context, ruleUUID, teamUUID uuid.UUID) error {
 	apiPath := fmt.Sprintf("/api/v1/notification/rule/%s/team/%s", ruleUUID, teamUUID)
-	return r.data.API().Do(ctx, http.MethodDelete, apiPath, nil, nil)
+	return r.data.API().Do(withConflictRetry(ctx), http.MethodDelete, apiPath, nil, nil)
 }
 
 func (r *NotificationRuleTeamResource) teamAssociationExists(ctx context.Context, ruleUUID, teamUUID uuid.UUID) (bool, error) {
@@ -2,11 +2,13 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
@@ -43,6 +45,22 @@ func TestAccNotificationRuleTeamResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Importing an association that does not exist fails
+			{
+				ResourceName:  "dependencytrack_notification_rule_team.test",
+				ImportState:   true,
+				ImportStateId: "00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000000",
+				ExpectError:   regexp.MustCompile(`notification rule not found`),
+			},
+			{
+				ResourceName: "dependencytrack_notification_rule_team.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["dependencytrack_notification_rule.test"].Primary.Attributes["uuid"] +
+						"/00000000-0000-0000-0000-000000000000", nil
+				},
+				ExpectError: regexp.MustCompile(`Association Not Found`),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
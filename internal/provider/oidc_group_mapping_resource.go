@@ -147,6 +147,10 @@ func (r *OIDCGroupMappingResource) Read(ctx context.Context, req resource.ReadRe
 
 	teams, err := r.data.Client.OIDC.GetAllTeamsOf(ctx, dtrack.OIDCGroup{UUID: groupUUID})
 	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC group mappings, got error: %s", err))
 		return
 	}
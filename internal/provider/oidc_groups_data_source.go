@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OIDCGroupsDataSource{}
+
+func NewOIDCGroupsDataSource() datasource.DataSource {
+	return &OIDCGroupsDataSource{}
+}
+
+// OIDCGroupsDataSource defines the data source implementation.
+type OIDCGroupsDataSource struct {
+	data *Data
+}
+
+// OIDCGroupsDataSourceModel describes the data source data model.
+type OIDCGroupsDataSourceModel struct {
+	ID     types.String         `tfsdk:"id"`
+	Groups []OIDCGroupDataModel `tfsdk:"groups"`
+}
+
+// OIDCGroupDataModel describes an individual OIDC group.
+type OIDCGroupDataModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *OIDCGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_groups"
+}
+
+func (d *OIDCGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves all OpenID Connect (OIDC) groups known to Dependency-Track, e.g. to map them to teams with `dependencytrack_oidc_group_mapping` by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this data source result (always `oidc_groups`).",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of OIDC groups, sorted by name",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the OIDC group",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the OIDC group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OIDCGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *OIDCGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OIDCGroupsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The OIDC group endpoint is not paginated, see
+	// OIDCGroupResource.findGroup.
+	groups, err := d.data.Client.OIDC.GetAllGroups(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC groups, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("oidc_groups")
+	data.Groups = make([]OIDCGroupDataModel, 0, len(groups))
+	for _, group := range groups {
+		data.Groups = append(data.Groups, OIDCGroupDataModel{
+			ID:   types.StringValue(group.UUID.String()),
+			Name: types.StringValue(group.Name),
+		})
+	}
+	slices.SortFunc(data.Groups, func(a, b OIDCGroupDataModel) int {
+		return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	tflog.Trace(ctx, "read an OIDC groups data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
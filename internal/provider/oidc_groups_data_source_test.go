@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccOIDCGroupsDataSource(t *testing.T) {
+	name := "tf-acc-oidc-groups-ds-" + randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOIDCGroupsDataSourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_oidc_groups.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("oidc_groups"),
+					),
+					statecheck.ExpectKnownOutputValue("group_id_matches", knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func testAccOIDCGroupsDataSourceConfig(name string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_oidc_group" "test" {
+  name = %q
+}
+
+data "dependencytrack_oidc_groups" "test" {
+  depends_on = [
+    dependencytrack_oidc_group.test
+  ]
+}
+
+output "group_id_matches" {
+  value = {
+    for group in data.dependencytrack_oidc_groups.test.groups : group.name => group.id
+  }[dependencytrack_oidc_group.test.name] == dependencytrack_oidc_group.test.id
+}
+`, name)
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionDataSource{}
+
+func NewPermissionDataSource() datasource.DataSource {
+	return &PermissionDataSource{}
+}
+
+// PermissionDataSource defines the data source implementation.
+type PermissionDataSource struct {
+	data *Data
+}
+
+// PermissionDataSourceModel describes the data source data model.
+type PermissionDataSourceModel struct {
+	ID          types.String              `tfsdk:"id"`
+	Name        types.String              `tfsdk:"name"`
+	Description types.String              `tfsdk:"description"`
+	Teams       []PermissionTeamDataModel `tfsdk:"teams"`
+	Users       []PermissionUserDataModel `tfsdk:"users"`
+}
+
+// PermissionTeamDataModel describes a team holding the permission.
+type PermissionTeamDataModel struct {
+	UUID types.String `tfsdk:"uuid"`
+	Name types.String `tfsdk:"name"`
+}
+
+// PermissionUserDataModel describes a user holding the permission directly.
+type PermissionUserDataModel struct {
+	Username types.String `tfsdk:"username"`
+	Type     types.String `tfsdk:"type"`
+}
+
+func (d *PermissionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (d *PermissionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a Dependency-Track permission and the teams and users it is granted to, e.g. for access reviews. " +
+			"Dependency-Track has no endpoint listing the holders of a permission, so all teams and managed, LDAP and OIDC users are scanned. " +
+			"Only direct grants are listed: members of the listed teams hold the permission as well.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the permission (same as `name`).",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name of the permission, e.g. `BOM_UPLOAD`.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Description of the permission.",
+			},
+			"teams": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Teams the permission is granted to, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "UUID of the team",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Name of the team",
+						},
+					},
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Users the permission is granted to directly (not through teams), sorted by type and username.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Username of the user",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Type of the user: `managed`, `ldap` or `oidc`",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *PermissionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+
+	permission, found, err := findInPages(ctx, d.data.Client.Permission.GetAll, func(p dtrack.Permission) bool {
+		return p.Name == name
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permissions, got error: %s", err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Permission Not Found", fmt.Sprintf("No permission found with name: %s", name))
+		return
+	}
+
+	teams, err := fetchAllPages(ctx, d.data.Client.Team.GetAll)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read teams, got error: %s", err))
+		return
+	}
+
+	managedUsers, err := fetchAllPages(ctx, d.data.Client.User.GetAllManaged)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read managed users, got error: %s", err))
+		return
+	}
+
+	ldapUsers, err := fetchAllPages(ctx, d.data.Client.LDAP.GetUsers)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LDAP users, got error: %s", err))
+		return
+	}
+
+	// client-go's OIDCService.GetAllUsers takes no PageOptions, see
+	// UserTeamMembershipResource.membershipIn.
+	oidcUsers, err := apiGetAllPages[dtrack.OIDCUser](ctx, d.data.API(), "/api/v1/user/oidc", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OIDC users, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(permission.Name)
+	data.Description = types.StringValue(permission.Description)
+
+	data.Teams = []PermissionTeamDataModel{}
+	for _, team := range teams {
+		if permissionsContain(team.Permissions, name) {
+			data.Teams = append(data.Teams, PermissionTeamDataModel{
+				UUID: types.StringValue(team.UUID.String()),
+				Name: types.StringValue(team.Name),
+			})
+		}
+	}
+	slices.SortFunc(data.Teams, func(a, b PermissionTeamDataModel) int {
+		return strings.Compare(a.Name.ValueString(), b.Name.ValueString())
+	})
+
+	// Users are appended per type in the order the types sort in.
+	data.Users = []PermissionUserDataModel{}
+	var usernames []string
+	for _, user := range ldapUsers {
+		if permissionsContain(user.Permissions, name) {
+			usernames = append(usernames, user.Username)
+		}
+	}
+	data.Users = appendPermissionUsers(data.Users, userTypeLDAP, usernames)
+
+	usernames = nil
+	for _, user := range managedUsers {
+		if permissionsContain(user.Permissions, name) {
+			usernames = append(usernames, user.Username)
+		}
+	}
+	data.Users = appendPermissionUsers(data.Users, userTypeManaged, usernames)
+
+	usernames = nil
+	for _, user := range oidcUsers {
+		if permissionsContain(user.Permissions, name) {
+			usernames = append(usernames, user.Username)
+		}
+	}
+	data.Users = appendPermissionUsers(data.Users, userTypeOIDC, usernames)
+
+	tflog.Trace(ctx, "read a permission data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// permissionsContain reports whether permissions includes the named one.
+func permissionsContain(permissions []dtrack.Permission, name string) bool {
+	return slices.ContainsFunc(permissions, func(p dtrack.Permission) bool {
+		return p.Name == name
+	})
+}
+
+// appendPermissionUsers appends the usernames, sorted, as users of userType.
+func appendPermissionUsers(users []PermissionUserDataModel, userType string, usernames []string) []PermissionUserDataModel {
+	slices.Sort(usernames)
+	for _, username := range usernames {
+		users = append(users, PermissionUserDataModel{
+			Username: types.StringValue(username),
+			Type:     types.StringValue(userType),
+		})
+	}
+	return users
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPermissionDataSource(t *testing.T) {
+	suffix := randomSuffix()
+	teamName := "Permission Data Source Team " + suffix
+	username := "permission_ds_user_" + suffix
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionDataSourceConfig(teamName, username),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_permission.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("VIEW_BADGES"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_permission.test",
+						tfjsonpath.New("description"),
+						knownvalue.NotNull(),
+					),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.dependencytrack_permission.test",
+						"teams.*",
+						map[string]string{"name": teamName},
+					),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"data.dependencytrack_permission.test",
+						"users.*",
+						map[string]string{"username": username, "type": "managed"},
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPermissionDataSource_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+data "dependencytrack_permission" "test" {
+  name = "NOT_A_PERMISSION"
+}
+`,
+				ExpectError: regexp.MustCompile(`Permission Not Found`),
+			},
+		},
+	})
+}
+
+func testAccPermissionDataSourceConfig(teamName, username string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+  name = %q
+}
+
+resource "dependencytrack_team_permissions" "test" {
+  team        = dependencytrack_team.test.id
+  permissions = ["VIEW_BADGES"]
+}
+
+resource "dependencytrack_managed_user" "test" {
+  username = %q
+  fullname = "Permission Data Source User"
+  email    = "permission.ds@example.com"
+  password = "TestPassword123!"
+}
+
+resource "dependencytrack_managed_user_permissions" "test" {
+  user        = dependencytrack_managed_user.test.id
+  permissions = ["VIEW_BADGES"]
+}
+
+data "dependencytrack_permission" "test" {
+  name = "VIEW_BADGES"
+
+  depends_on = [
+    dependencytrack_team_permissions.test,
+    dependencytrack_managed_user_permissions.test,
+  ]
+}
+`, teamName, username)
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PoliciesDataSource{}
+
+func NewPoliciesDataSource() datasource.DataSource {
+	return &PoliciesDataSource{}
+}
+
+// PoliciesDataSource defines the data source implementation.
+type PoliciesDataSource struct {
+	data *Data
+}
+
+// PoliciesDataSourceModel describes the data source data model.
+type PoliciesDataSourceModel struct {
+	ID       types.String      `tfsdk:"id"`
+	Policies []PolicyDataModel `tfsdk:"policies"`
+}
+
+// PolicyDataModel describes an individual policy.
+type PolicyDataModel struct {
+	UUID           types.String `tfsdk:"uuid"`
+	Name           types.String `tfsdk:"name"`
+	Operator       types.String `tfsdk:"operator"`
+	ViolationState types.String `tfsdk:"violation_state"`
+	ConditionCount types.Int64  `tfsdk:"condition_count"`
+}
+
+func (d *PoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *PoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves all policies of Dependency-Track, e.g. for reports or to iterate over existing policies. " +
+			"Use the `dependencytrack_policy` data source for the conditions of a single policy.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this data source result (always `policies`).",
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of policies",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the policy",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the policy",
+						},
+						"operator": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The operator used when evaluating conditions (ALL or ANY)",
+						},
+						"violation_state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The violation state (INFO, WARN, or FAIL)",
+						},
+						"condition_count": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The number of conditions of the policy",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *PoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoliciesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policies, err := fetchAllPages(ctx, d.data.Client.Policy.GetAll)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policies, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("policies")
+	data.Policies = make([]PolicyDataModel, 0, len(policies))
+	for _, policy := range policies {
+		data.Policies = append(data.Policies, PolicyDataModel{
+			UUID:           types.StringValue(policy.UUID.String()),
+			Name:           types.StringValue(policy.Name),
+			Operator:       types.StringValue(string(policy.Operator)),
+			ViolationState: types.StringValue(string(policy.ViolationState)),
+			ConditionCount: types.Int64Value(int64(len(policy.PolicyConditions))),
+		})
+	}
+
+	tflog.Trace(ctx, "read a policies data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
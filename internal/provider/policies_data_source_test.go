@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccPoliciesDataSource(t *testing.T) {
+	name := "tf-acc-policies-ds-" + randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesDataSourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_policies.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("policies"),
+					),
+					statecheck.ExpectKnownOutputValue("test_policy", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"uuid":            knownvalue.NotNull(),
+						"name":            knownvalue.StringExact(name),
+						"operator":        knownvalue.StringExact("ANY"),
+						"violation_state": knownvalue.StringExact("WARN"),
+						"condition_count": knownvalue.Int64Exact(2),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccPoliciesDataSourceConfig(name string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_policy" "test" {
+  name            = %[1]q
+  operator        = "ANY"
+  violation_state = "WARN"
+
+  conditions = [
+    {
+      subject  = "SEVERITY"
+      operator = "IS"
+      value    = "CRITICAL"
+    },
+    {
+      subject  = "LICENSE"
+      operator = "IS"
+      value    = "GPL-3.0"
+    }
+  ]
+}
+
+data "dependencytrack_policies" "test" {
+  depends_on = [
+    dependencytrack_policy.test
+  ]
+}
+
+output "test_policy" {
+  value = one([
+    for policy in data.dependencytrack_policies.test.policies : policy
+    if policy.uuid == dependencytrack_policy.test.id
+  ])
+}
+`, name)
+}
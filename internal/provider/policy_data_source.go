@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -41,20 +46,28 @@ func (d *PolicyDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *PolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Retrieves information about a Dependency-Track policy.",
+		MarkdownDescription: "Retrieves information about a Dependency-Track policy. The policy can be looked up by either `id` or `name`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The UUID of the policy (same as uuid)",
-				Required:            true,
+				MarkdownDescription: "The UUID of the policy (same as uuid). Either `id` or `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("name")),
+				},
 			},
 			"uuid": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The UUID of the policy",
 			},
 			"name": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The name of the policy",
+				MarkdownDescription: "The name of the policy. Either `id` or `name` must be specified. It is an error if more than one policy has this name.",
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("id")),
+				},
 			},
 			"operator": schema.StringAttribute{
 				Computed:            true,
@@ -74,7 +87,7 @@ func (d *PolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			},
 			"conditions": schema.ListNestedAttribute{
 				Computed:            true,
-				MarkdownDescription: "List of policy conditions",
+				MarkdownDescription: "List of policy conditions, including their UUIDs. Null when the policy has no conditions.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"uuid": schema.StringAttribute{
@@ -127,10 +140,28 @@ func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	policyUUID, err := uuid.Parse(data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse policy UUID: %s", err))
-		return
+	var policyUUID uuid.UUID
+	if !data.ID.IsNull() && data.ID.ValueString() != "" {
+		parsed, err := uuid.Parse(data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse policy UUID: %s", err))
+			return
+		}
+		policyUUID = parsed
+	} else {
+		// The API has no lookup by name, so page through all policies.
+		policies, err := fetchAllPages(ctx, d.data.Client.Policy.GetAll)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list policies, got error: %s", err))
+			return
+		}
+
+		match, err := findPolicyByName(policies, data.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Policy Lookup Failed", err.Error())
+			return
+		}
+		policyUUID = match.UUID
 	}
 
 	policy, err := d.data.Client.Policy.Get(ctx, policyUUID)
@@ -146,6 +177,7 @@ func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Update model with values from API
+	data.ID = types.StringValue(policy.UUID.String())
 	data.UUID = types.StringValue(policy.UUID.String())
 	data.Name = types.StringValue(policy.Name)
 	data.Operator = types.StringValue(string(policy.Operator))
@@ -201,3 +233,28 @@ func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// findPolicyByName returns the single policy named name. Policy names are not
+// guaranteed to be unique, so an ambiguous match is reported as an error
+// rather than silently picking one.
+func findPolicyByName(policies []dtrack.Policy, name string) (dtrack.Policy, error) {
+	var matches []dtrack.Policy
+	for _, p := range policies {
+		if p.Name == name {
+			matches = append(matches, p)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return dtrack.Policy{}, fmt.Errorf("no policy found with name: %s", name)
+	case 1:
+		return matches[0], nil
+	default:
+		uuids := make([]string, 0, len(matches))
+		for _, p := range matches {
+			uuids = append(uuids, p.UUID.String())
+		}
+		return dtrack.Policy{}, fmt.Errorf("found %d policies named %q (%s); look the policy up by id instead", len(matches), name, strings.Join(uuids, ", "))
+	}
+}
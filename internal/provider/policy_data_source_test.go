@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -61,3 +65,107 @@ data "dependencytrack_policy" "test" {
 }
 `
 }
+
+func TestAccPolicyDataSource_ByName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDataSourceConfigByName(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_policy.test",
+						tfjsonpath.New("violation_state"),
+						knownvalue.StringExact("WARN"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_policy.test",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_policy.test",
+						tfjsonpath.New("conditions"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicyDataSource_MissingCriteria(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+data "dependencytrack_policy" "test" {}
+`,
+				ExpectError: regexp.MustCompile(`At least one attribute out of`),
+			},
+		},
+	})
+}
+
+func testAccPolicyDataSourceConfigByName() string {
+	return testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_policy" "test" {
+  name            = "Data Source By Name Test Policy"
+  operator        = "ANY"
+  violation_state = "WARN"
+
+  conditions = [
+    {
+      subject  = "SEVERITY"
+      operator = "IS"
+      value    = "HIGH"
+    }
+  ]
+}
+
+data "dependencytrack_policy" "test" {
+  name = dependencytrack_policy.test.name
+}
+`
+}
+
+func TestFindPolicyByName(t *testing.T) {
+	a := dtrack.Policy{UUID: uuid.New(), Name: "alpha"}
+	b := dtrack.Policy{UUID: uuid.New(), Name: "beta"}
+	dup := dtrack.Policy{UUID: uuid.New(), Name: "beta"}
+
+	tests := []struct {
+		name     string
+		policies []dtrack.Policy
+		search   string
+		want     uuid.UUID
+		wantErr  string
+	}{
+		{name: "single match", policies: []dtrack.Policy{a, b}, search: "alpha", want: a.UUID},
+		{name: "no match", policies: []dtrack.Policy{a, b}, search: "gamma", wantErr: "no policy found"},
+		{name: "empty list", policies: nil, search: "alpha", wantErr: "no policy found"},
+		{name: "ambiguous", policies: []dtrack.Policy{a, b, dup}, search: "beta", wantErr: "found 2 policies"},
+		{name: "case sensitive", policies: []dtrack.Policy{a}, search: "Alpha", wantErr: "no policy found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := findPolicyByName(tt.policies, tt.search)
+			if tt.wantErr != "" {
+				if err == nil || !regexp.MustCompile(regexp.QuoteMeta(tt.wantErr)).MatchString(err.Error()) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.UUID != tt.want {
+				t.Errorf("got UUID %s, want %s", got.UUID, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,13 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
@@ -23,6 +29,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &PolicyResource{}
 var _ resource.ResourceWithImportState = &PolicyResource{}
+var _ resource.ResourceWithValidateConfig = &PolicyResource{}
+var _ resource.ResourceWithModifyPlan = &PolicyResource{}
 
 func NewPolicyResource() resource.Resource {
 	return &PolicyResource{}
@@ -59,6 +67,13 @@ type PolicyConditionModel struct {
 	Value    types.String `tfsdk:"value"`
 }
 
+var policyConditionAttrTypes = map[string]attr.Type{
+	"uuid":     types.StringType,
+	"subject":  types.StringType,
+	"operator": types.StringType,
+	"value":    types.StringType,
+}
+
 func (r *PolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_policy"
 }
@@ -135,8 +150,13 @@ func (r *PolicyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							},
 						},
 						"value": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "The value to compare against",
+							Required: true,
+							MarkdownDescription: "The value to compare against. For `LICENSE_GROUP` conditions this is the license group UUID, " +
+								"which the `dependencytrack_license_group` data source resolves from the group name. " +
+								"COORDINATES values are a JSON object of `group`, `name` and `version` patterns, e.g. `{\"group\":\"org.example\",\"name\":\"app\"}`, " +
+								"and PACKAGE_URL values a regular expression matched against the package URL. " +
+								"SEVERITY, AGE (an ISO-8601 period such as `P30D`), EPSS, VERSION_DISTANCE (a JSON object), COORDINATES, LICENSE_GROUP and CWE values, " +
+								"and the operators allowed for each subject, are checked at plan time.",
 						},
 					},
 				},
@@ -163,6 +183,116 @@ func (r *PolicyResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.data = data
 }
 
+func (r *PolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Conditions.IsNull() || data.Conditions.IsUnknown() {
+		return
+	}
+
+	var conditions []PolicyConditionModel
+	resp.Diagnostics.Append(data.Conditions.ElementsAs(ctx, &conditions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Dependency-Track stores any subject, operator and value combination and
+	// only fails to match when it evaluates the condition, so check what can
+	// be checked at plan time.
+	for i, condition := range conditions {
+		if condition.Subject.IsUnknown() || condition.Subject.IsNull() {
+			continue
+		}
+		subject := condition.Subject.ValueString()
+		conditionPath := path.Root("conditions").AtListIndex(i)
+
+		if !condition.Operator.IsUnknown() && !condition.Operator.IsNull() {
+			if err := validatePolicyConditionOperator(subject, condition.Operator.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					conditionPath.AtName("operator"),
+					"Invalid Policy Condition Operator",
+					err.Error(),
+				)
+			}
+		}
+
+		if !condition.Value.IsUnknown() && !condition.Value.IsNull() {
+			if err := validatePolicyConditionValue(subject, condition.Value.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					conditionPath.AtName("value"),
+					"Invalid Policy Condition Value",
+					fmt.Sprintf("Invalid value %q for a %s condition: %s", condition.Value.ValueString(), subject, err),
+				)
+			} else if warning := policyConditionValueWarning(subject, condition.Value.ValueString()); warning != "" {
+				resp.Diagnostics.AddAttributeWarning(
+					conditionPath.AtName("value"),
+					"Policy Condition Value May Never Match",
+					warning+". Dependency-Track evaluates it as a Java regular expression, so the condition may never fire.",
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan matches the configured conditions to the conditions in state, so
+// that a condition keeps its UUID when other conditions are added, removed or
+// reordered, and an imported policy plans no changes when its conditions come
+// back in another order than they are configured in.
+func (r *PolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, state PolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || config.Conditions.IsNull() || config.Conditions.IsUnknown() || state.Conditions.IsNull() {
+		return
+	}
+
+	var configConditions, stateConditions []PolicyConditionModel
+	resp.Diagnostics.Append(config.Conditions.ElementsAs(ctx, &configConditions, false)...)
+	resp.Diagnostics.Append(state.Conditions.ElementsAs(ctx, &stateConditions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conditions, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: policyConditionAttrTypes}, matchPolicyConditions(configConditions, stateConditions))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("conditions"), conditions)...)
+}
+
+// matchPolicyConditions returns the configured conditions, each with the UUID
+// of an existing condition with the same subject, operator and value. Each
+// existing condition is used once; configured conditions without a match get
+// an unknown UUID and are created on apply.
+func matchPolicyConditions(configured, existing []PolicyConditionModel) []PolicyConditionModel {
+	used := make([]bool, len(existing))
+	matched := make([]PolicyConditionModel, 0, len(configured))
+	for _, condition := range configured {
+		condition.UUID = types.StringUnknown()
+		for i, candidate := range existing {
+			if used[i] || !candidate.Subject.Equal(condition.Subject) || !candidate.Operator.Equal(condition.Operator) || !candidate.Value.Equal(condition.Value) {
+				continue
+			}
+			used[i] = true
+			condition.UUID = candidate.UUID
+			break
+		}
+		matched = append(matched, condition)
+	}
+	return matched
+}
+
 func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data PolicyResourceModel
 
@@ -187,8 +317,9 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Create conditions if any
+	// Create conditions if any, remembering their order
 	var conditions []PolicyConditionModel
+	var conditionOrder []string
 	if !data.Conditions.IsNull() && !data.Conditions.IsUnknown() {
 		resp.Diagnostics.Append(data.Conditions.ElementsAs(ctx, &conditions, false)...)
 		if resp.Diagnostics.HasError() {
@@ -209,18 +340,21 @@ func (r *PolicyResource) Create(ctx context.Context, req resource.CreateRequest,
 			}
 
 			conditions[i].UUID = types.StringValue(createdCondition.UUID.String())
+			conditionOrder = append(conditionOrder, createdCondition.UUID.String())
 		}
 	}
 
 	// Read back the policy to get complete state
-	readPolicy, err := r.data.Client.Policy.Get(ctx, createdPolicy.UUID)
+	readPolicy, err := getCreated(ctx, func(ctx context.Context) (dtrack.Policy, error) {
+		return r.data.Client.Policy.Get(ctx, createdPolicy.UUID)
+	})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy after create, got error: %s", err))
 		return
 	}
 
 	// Update model with created values
-	r.updateModelFromAPI(&data, &readPolicy)
+	r.updateModelFromAPI(&data, &readPolicy, conditionOrder)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -242,13 +376,29 @@ func (r *PolicyResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 	policy, err := r.data.Client.Policy.Get(ctx, policyUUID)
 	if err != nil {
-		// If policy not found, remove from state
-		resp.State.RemoveResource(ctx)
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
 		return
 	}
 
-	// Update model with values from API
-	r.updateModelFromAPI(&data, &policy)
+	// Update model with values from API, keeping the conditions in the order
+	// they are in state. On import there is no state yet and the server's
+	// order is kept; ModifyPlan then matches them to the configuration.
+	var conditionOrder []string
+	if !data.Conditions.IsNull() && !data.Conditions.IsUnknown() {
+		var conditions []PolicyConditionModel
+		resp.Diagnostics.Append(data.Conditions.ElementsAs(ctx, &conditions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, condition := range conditions {
+			conditionOrder = append(conditionOrder, condition.UUID.ValueString())
+		}
+	}
+	r.updateModelFromAPI(&data, &policy, conditionOrder)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -296,8 +446,20 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Delete all existing conditions
+	// ModifyPlan gave the conditions that are unchanged the UUID they have in
+	// state. Those are kept, the other existing conditions are deleted, and
+	// the conditions without a UUID are created.
+	keep := make(map[string]bool, len(planConditions))
+	for _, condition := range planConditions {
+		if !condition.UUID.IsUnknown() && !condition.UUID.IsNull() {
+			keep[condition.UUID.ValueString()] = true
+		}
+	}
+
 	for _, condition := range stateConditions {
+		if keep[condition.UUID.ValueString()] {
+			continue
+		}
 		condUUID, err := uuid.Parse(condition.UUID.ValueString())
 		if err != nil {
 			continue // Skip invalid UUIDs
@@ -309,8 +471,13 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	// Create new conditions
+	conditionOrder := make([]string, 0, len(planConditions))
 	for i, condition := range planConditions {
+		if keep[condition.UUID.ValueString()] {
+			conditionOrder = append(conditionOrder, condition.UUID.ValueString())
+			continue
+		}
+
 		apiCondition := dtrack.PolicyCondition{
 			Subject:  dtrack.PolicyConditionSubject(condition.Subject.ValueString()),
 			Operator: dtrack.PolicyConditionOperator(condition.Operator.ValueString()),
@@ -324,6 +491,7 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 
 		planConditions[i].UUID = types.StringValue(createdCondition.UUID.String())
+		conditionOrder = append(conditionOrder, createdCondition.UUID.String())
 	}
 
 	// Read back the policy to get complete state
@@ -334,7 +502,7 @@ func (r *PolicyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update model with updated values
-	r.updateModelFromAPI(&plan, &readPolicy)
+	r.updateModelFromAPI(&plan, &readPolicy, conditionOrder)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -375,8 +543,9 @@ func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportSta
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 }
 
-// Helper method to update model from client library Policy struct.
-func (r *PolicyResource) updateModelFromAPI(data *PolicyResourceModel, policy *dtrack.Policy) {
+// Helper method to update model from client library Policy struct. The
+// conditions are ordered by conditionOrder, see sortPolicyConditions.
+func (r *PolicyResource) updateModelFromAPI(data *PolicyResourceModel, policy *dtrack.Policy, conditionOrder []string) {
 	data.ID = types.StringValue(policy.UUID.String())
 	data.Name = types.StringValue(policy.Name)
 	data.Operator = types.StringValue(string(policy.Operator))
@@ -387,14 +556,9 @@ func (r *PolicyResource) updateModelFromAPI(data *PolicyResourceModel, policy *d
 	// Update conditions
 	if len(policy.PolicyConditions) > 0 {
 		conditionElements := make([]attr.Value, 0, len(policy.PolicyConditions))
-		for _, cond := range policy.PolicyConditions {
+		for _, cond := range sortPolicyConditions(policy.PolicyConditions, conditionOrder) {
 			conditionElements = append(conditionElements, types.ObjectValueMust(
-				map[string]attr.Type{
-					"uuid":     types.StringType,
-					"subject":  types.StringType,
-					"operator": types.StringType,
-					"value":    types.StringType,
-				},
+				policyConditionAttrTypes,
 				map[string]attr.Value{
 					"uuid":     types.StringValue(cond.UUID.String()),
 					"subject":  types.StringValue(string(cond.Subject)),
@@ -403,26 +567,189 @@ func (r *PolicyResource) updateModelFromAPI(data *PolicyResourceModel, policy *d
 				},
 			))
 		}
-		conditionsList, _ := types.ListValue(
-			types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"uuid":     types.StringType,
-					"subject":  types.StringType,
-					"operator": types.StringType,
-					"value":    types.StringType,
-				},
-			},
-			conditionElements,
-		)
+		conditionsList, _ := types.ListValue(types.ObjectType{AttrTypes: policyConditionAttrTypes}, conditionElements)
 		data.Conditions = conditionsList
 	} else {
-		data.Conditions = types.ListNull(types.ObjectType{
-			AttrTypes: map[string]attr.Type{
-				"uuid":     types.StringType,
-				"subject":  types.StringType,
-				"operator": types.StringType,
-				"value":    types.StringType,
-			},
-		})
+		data.Conditions = types.ListNull(types.ObjectType{AttrTypes: policyConditionAttrTypes})
+	}
+}
+
+// sortPolicyConditions returns conditions ordered by the condition UUIDs in
+// order, the order of the conditions in the configuration. The conditions
+// list is ordered, but Dependency-Track does not promise to return a policy's
+// conditions in any particular order. Conditions missing from order, e.g. all
+// of them on import, follow the others in the order they were returned in.
+func sortPolicyConditions(conditions []dtrack.PolicyCondition, order []string) []dtrack.PolicyCondition {
+	position := make(map[string]int, len(order))
+	for i, conditionUUID := range order {
+		position[conditionUUID] = i
+	}
+
+	sorted := slices.Clone(conditions)
+	slices.SortStableFunc(sorted, func(a, b dtrack.PolicyCondition) int {
+		posA, okA := position[a.UUID.String()]
+		posB, okB := position[b.UUID.String()]
+		switch {
+		case okA && okB:
+			return posA - posB
+		case okA:
+			return -1
+		case okB:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return sorted
+}
+
+var (
+	// policyConditionNumericSubjects are the condition subjects Dependency-Track
+	// compares with the NUMERIC_* operators, and only with those.
+	policyConditionNumericSubjects = []string{"AGE", "EPSS", "VERSION", "VERSION_DISTANCE"}
+
+	// policyConditionSeverities are the values of a SEVERITY condition.
+	policyConditionSeverities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFO", "UNASSIGNED"}
+
+	// isoPeriodRegex matches an ISO-8601 period as parsed by Java's
+	// Period.parse, which Dependency-Track uses for AGE conditions.
+	isoPeriodRegex = regexp.MustCompile(`(?i)^[-+]?P(?:[-+]?\d+Y)?(?:[-+]?\d+M)?(?:[-+]?\d+W)?(?:[-+]?\d+D)?$`)
+
+	// cweRegex matches a single CWE of a CWE condition, with or without the
+	// CWE- prefix.
+	cweRegex = regexp.MustCompile(`(?i)^(?:CWE-)?\d+$`)
+)
+
+// validatePolicyConditionOperator checks that operator is one Dependency-Track
+// evaluates for conditions on subject.
+func validatePolicyConditionOperator(subject, operator string) error {
+	numericSubject := slices.Contains(policyConditionNumericSubjects, subject)
+	numericOperator := strings.HasPrefix(operator, "NUMERIC_")
+
+	switch {
+	case numericSubject && !numericOperator:
+		return fmt.Errorf("%s conditions must use one of the NUMERIC_* operators, got %s", subject, operator)
+	case !numericSubject && numericOperator:
+		return fmt.Errorf("the %s operator can only be used with %s conditions", operator, strings.Join(policyConditionNumericSubjects, ", "))
+	}
+
+	containsOperator := operator == "CONTAINS_ALL" || operator == "CONTAINS_ANY"
+	switch subject {
+	case "CWE":
+		if !containsOperator {
+			return fmt.Errorf("CWE conditions must use CONTAINS_ALL or CONTAINS_ANY, got %s", operator)
+		}
+	case "SEVERITY", "LICENSE", "LICENSE_GROUP":
+		if operator != "IS" && operator != "IS_NOT" {
+			return fmt.Errorf("%s conditions must use IS or IS_NOT, got %s", subject, operator)
+		}
+	default:
+		if containsOperator {
+			return fmt.Errorf("the %s operator can only be used with CWE conditions", operator)
+		}
+	}
+
+	return nil
+}
+
+// validatePolicyConditionValue checks that value has the format Dependency-Track
+// expects for conditions on subject. Subjects with free-form values are not
+// checked.
+func validatePolicyConditionValue(subject, value string) error {
+	switch subject {
+	case "SEVERITY":
+		if !slices.Contains(policyConditionSeverities, value) {
+			return fmt.Errorf("must be one of %s", strings.Join(policyConditionSeverities, ", "))
+		}
+	case "AGE":
+		if !isoPeriodRegex.MatchString(value) || strings.EqualFold(strings.TrimLeft(value, "+-"), "P") {
+			return fmt.Errorf("must be an ISO-8601 period such as P30D or P1Y6M")
+		}
+	case "EPSS":
+		score, err := strconv.ParseFloat(value, 64)
+		if err != nil || score < 0 || score > 1 {
+			return fmt.Errorf("must be a number between 0 and 1")
+		}
+	case "VERSION_DISTANCE":
+		var distance map[string]any
+		if err := json.Unmarshal([]byte(value), &distance); err != nil {
+			return fmt.Errorf(`must be a JSON object such as {"major":"1"}`)
+		}
+	case "COORDINATES":
+		return validateCoordinatesConditionValue(value)
+	case "LICENSE_GROUP":
+		if _, err := uuid.Parse(value); err != nil {
+			return fmt.Errorf("must be the UUID of a license group")
+		}
+	case "CWE":
+		for _, cwe := range strings.Split(value, ",") {
+			if !cweRegex.MatchString(strings.TrimSpace(cwe)) {
+				return fmt.Errorf("must be a comma-separated list of CWEs such as CWE-79, CWE-89")
+			}
+		}
+	}
+
+	return nil
+}
+
+// policyConditionCoordinatesFields are the fields of a COORDINATES condition
+// value.
+var policyConditionCoordinatesFields = []string{"group", "name", "version"}
+
+// validateCoordinatesConditionValue checks that value is the JSON object of
+// group, name and version patterns Dependency-Track expects for COORDINATES
+// conditions, naming the offending field when one is malformed.
+func validateCoordinatesConditionValue(value string) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &fields); err != nil || fields == nil {
+		return fmt.Errorf(`must be a JSON object such as {"group":"org.example","name":"app","version":">=1.0.0"}`)
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("must set at least one of the %s fields", strings.Join(policyConditionCoordinatesFields, ", "))
 	}
+
+	keys := slices.Sorted(maps.Keys(fields))
+	for _, key := range keys {
+		if !slices.Contains(policyConditionCoordinatesFields, key) {
+			return fmt.Errorf("unknown field %q, expected only %s", key, strings.Join(policyConditionCoordinatesFields, ", "))
+		}
+		var pattern string
+		if err := json.Unmarshal(fields[key], &pattern); err != nil {
+			return fmt.Errorf("field %q must be a string", key)
+		}
+	}
+
+	return nil
+}
+
+// policyConditionValueWarning returns a reason to double-check value for
+// conditions on subject, or "" if there is none. Dependency-Track matches
+// PACKAGE_URL values and COORDINATES group and name fields as Java regular
+// expressions. Those are close enough to Go's that a pattern Go rejects is
+// most likely a mistake, but not so close that it is certainly one.
+func policyConditionValueWarning(subject, value string) string {
+	var patterns map[string]string
+	switch subject {
+	case "PACKAGE_URL":
+		patterns = map[string]string{"value": value}
+	case "COORDINATES":
+		var fields map[string]string
+		if err := json.Unmarshal([]byte(value), &fields); err != nil {
+			return ""
+		}
+		patterns = map[string]string{"group": fields["group"], "name": fields["name"]}
+	default:
+		return ""
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(patterns)) {
+		if _, err := regexp.Compile(patterns[key]); err != nil {
+			if key == "value" {
+				return fmt.Sprintf("The value does not parse as a regular expression: %s", err)
+			}
+			return fmt.Sprintf("The %q field does not parse as a regular expression: %s", key, err)
+		}
+	}
+
+	return ""
 }
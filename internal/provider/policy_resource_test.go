@@ -2,8 +2,13 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -126,6 +131,31 @@ func TestAccPolicyResource_WithConditions(t *testing.T) {
 	})
 }
 
+func TestAccPolicyResource_InvalidCondition(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_policy" "test" {
+  name = "Policy with Invalid Condition"
+
+  conditions = [
+    {
+      subject  = "SEVERITY"
+      operator = "IS"
+      value    = "BANANA"
+    }
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Policy Condition Value`),
+			},
+		},
+	})
+}
+
 func testAccPolicyResourceConfigWithConditions() string {
 	return testAccProviderConfigWithAPIKey() + `
 resource "dependencytrack_policy" "test" {
@@ -166,3 +196,176 @@ resource "dependencytrack_policy" "test" {
 }
 `
 }
+
+func TestValidatePolicyConditionOperator(t *testing.T) {
+	tests := []struct {
+		subject  string
+		operator string
+		wantErr  bool
+	}{
+		{subject: "SEVERITY", operator: "IS"},
+		{subject: "SEVERITY", operator: "MATCHES", wantErr: true},
+		{subject: "AGE", operator: "NUMERIC_GREATER_THAN"},
+		{subject: "AGE", operator: "IS", wantErr: true},
+		{subject: "VERSION", operator: "NUMERIC_LESS_THAN"},
+		{subject: "PACKAGE_URL", operator: "MATCHES"},
+		{subject: "PACKAGE_URL", operator: "NUMERIC_EQUAL", wantErr: true},
+		{subject: "CWE", operator: "CONTAINS_ANY"},
+		{subject: "CWE", operator: "IS", wantErr: true},
+		{subject: "COORDINATES", operator: "CONTAINS_ALL", wantErr: true},
+		{subject: "LICENSE_GROUP", operator: "IS_NOT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.subject+"/"+tt.operator, func(t *testing.T) {
+			err := validatePolicyConditionOperator(tt.subject, tt.operator)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePolicyConditionOperator(%q, %q) error = %v, wantErr %v", tt.subject, tt.operator, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePolicyConditionValue(t *testing.T) {
+	tests := []struct {
+		subject string
+		value   string
+		wantErr bool
+	}{
+		{subject: "SEVERITY", value: "CRITICAL"},
+		{subject: "SEVERITY", value: "BANANA", wantErr: true},
+		{subject: "SEVERITY", value: "critical", wantErr: true},
+		{subject: "AGE", value: "P30D"},
+		{subject: "AGE", value: "P1Y6M"},
+		{subject: "AGE", value: "P", wantErr: true},
+		{subject: "AGE", value: "30", wantErr: true},
+		{subject: "EPSS", value: "0.5"},
+		{subject: "EPSS", value: "1.5", wantErr: true},
+		{subject: "EPSS", value: "high", wantErr: true},
+		{subject: "VERSION_DISTANCE", value: `{"major":"1","minor":"?"}`},
+		{subject: "VERSION_DISTANCE", value: "1", wantErr: true},
+		{subject: "LICENSE_GROUP", value: "6b9c3c9e-3f0a-4c7e-8d2a-5c1f0e4b7a21"},
+		{subject: "LICENSE_GROUP", value: "Copyleft", wantErr: true},
+		{subject: "CWE", value: "CWE-79, 89"},
+		{subject: "CWE", value: "XSS", wantErr: true},
+		{subject: "PACKAGE_URL", value: "anything goes"},
+		{subject: "COORDINATES", value: `{"group":"org.example","name":"app.*","version":">=1.0.0"}`},
+		{subject: "COORDINATES", value: `{"name":"app"}`},
+		{subject: "COORDINATES", value: "org.example:app", wantErr: true},
+		{subject: "COORDINATES", value: `{}`, wantErr: true},
+		{subject: "COORDINATES", value: `{"artifact":"app"}`, wantErr: true},
+		{subject: "COORDINATES", value: `{"name":1}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.subject+"/"+tt.value, func(t *testing.T) {
+			err := validatePolicyConditionValue(tt.subject, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePolicyConditionValue(%q, %q) error = %v, wantErr %v", tt.subject, tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyConditionValueWarning(t *testing.T) {
+	tests := []struct {
+		subject string
+		value   string
+		want    string
+	}{
+		{subject: "PACKAGE_URL", value: `^pkg:maven/org\.example/.*$`},
+		{subject: "PACKAGE_URL", value: `pkg:maven/(org.example`, want: "The value does not parse"},
+		{subject: "COORDINATES", value: `{"group":"org.example","name":"app["}`, want: `The "name" field does not parse`},
+		{subject: "COORDINATES", value: `{"group":"org.example","version":"["}`},
+		{subject: "SEVERITY", value: "("},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.subject+"/"+tt.value, func(t *testing.T) {
+			got := policyConditionValueWarning(tt.subject, tt.value)
+			if tt.want == "" && got != "" || !strings.HasPrefix(got, tt.want) {
+				t.Errorf("policyConditionValueWarning(%q, %q) = %q, want prefix %q", tt.subject, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortPolicyConditions(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	conditions := []dtrack.PolicyCondition{
+		{UUID: a, Subject: dtrack.PolicyConditionSubjectSeverity},
+		{UUID: b, Subject: dtrack.PolicyConditionSubjectAge},
+		{UUID: c, Subject: dtrack.PolicyConditionSubjectLicense},
+	}
+
+	tests := []struct {
+		name  string
+		order []string
+		want  []uuid.UUID
+	}{
+		{name: "no order", want: []uuid.UUID{a, b, c}},
+		{name: "full order", order: []string{c.String(), a.String(), b.String()}, want: []uuid.UUID{c, a, b}},
+		{name: "partial order", order: []string{c.String()}, want: []uuid.UUID{c, a, b}},
+		{name: "unknown UUIDs", order: []string{uuid.NewString(), b.String(), ""}, want: []uuid.UUID{b, a, c}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []uuid.UUID
+			for _, condition := range sortPolicyConditions(conditions, tt.order) {
+				got = append(got, condition.UUID)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("sortPolicyConditions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPolicyConditions(t *testing.T) {
+	condition := func(id, subject, value string) PolicyConditionModel {
+		c := PolicyConditionModel{
+			UUID:     types.StringNull(),
+			Subject:  types.StringValue(subject),
+			Operator: types.StringValue("IS"),
+			Value:    types.StringValue(value),
+		}
+		if id != "" {
+			c.UUID = types.StringValue(id)
+		}
+		return c
+	}
+
+	existing := []PolicyConditionModel{
+		condition("a", "LICENSE", "MIT"),
+		condition("b", "SEVERITY", "HIGH"),
+		condition("c", "SEVERITY", "HIGH"),
+	}
+	configured := []PolicyConditionModel{
+		condition("", "SEVERITY", "HIGH"),
+		condition("", "SEVERITY", "CRITICAL"),
+		condition("", "LICENSE", "MIT"),
+		condition("", "SEVERITY", "HIGH"),
+		condition("", "SEVERITY", "HIGH"),
+	}
+
+	got := matchPolicyConditions(configured, existing)
+	want := []types.String{
+		types.StringValue("b"),
+		types.StringUnknown(),
+		types.StringValue("a"),
+		types.StringValue("c"),
+		types.StringUnknown(),
+	}
+	if len(got) != len(want) {
+		t.Fatalf("matchPolicyConditions() returned %d conditions, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].UUID.Equal(want[i]) {
+			t.Errorf("condition %d UUID = %s, want %s", i, got[i].UUID, want[i])
+		}
+		if !got[i].Value.Equal(configured[i].Value) {
+			t.Errorf("condition %d value = %s, want %s", i, got[i].Value, configured[i].Value)
+		}
+	}
+}
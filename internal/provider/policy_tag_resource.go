@@ -135,28 +135,20 @@ func (r *PolicyTagResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	tag := strings.ToLower(data.Tag.ValueString())
-
-	// The tagged-policies listing of a nonexistent tag is an empty list (not
-	// an error) on both DT v4 and v5, so a deleted tag also falls into the
-	// not-found path below.
-	policies, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
-		return r.data.Client.Tag.GetPolicies(ctx, tag, po, dtrack.SortOptions{})
-	})
+	// Read the association back from the policy itself: a single request that
+	// returns the policy's full tag list, and a deleted policy surfaces as a
+	// 404 instead of an empty tagged-policies page.
+	policy, err := r.data.Client.Policy.Get(ctx, policyUUID)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tagged policies, got error: %s", err))
-		return
-	}
-
-	found := false
-	for i := range policies {
-		if policies[i].UUID == policyUUID {
-			found = true
-			break
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy, got error: %s", err))
+		return
 	}
 
-	if !found {
+	if !policyHasTag(policy, data.Tag.ValueString()) {
 		// Tag is not assigned to the policy anymore, remove from state
 		resp.State.RemoveResource(ctx)
 		return
@@ -226,3 +218,14 @@ func (r *PolicyTagResource) ImportState(ctx context.Context, req resource.Import
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), tag)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy"), policyUUID.String())...)
 }
+
+// policyHasTag reports whether the policy carries the named tag. Dependency-Track
+// lowercases tag names on write, so the comparison is case-insensitive.
+func policyHasTag(policy dtrack.Policy, tag string) bool {
+	for _, t := range policy.Tags {
+		if strings.EqualFold(t.Name, tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	dtrack "github.com/DependencyTrack/client-go"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -66,3 +68,27 @@ resource "dependencytrack_policy_tag" "test" {
 }
 `, suffix, suffix)
 }
+
+func TestPolicyHasTag(t *testing.T) {
+	policy := dtrack.Policy{Tags: []dtrack.Tag{{Name: "prod"}, {Name: "team-a"}}}
+
+	tests := []struct {
+		name   string
+		policy dtrack.Policy
+		tag    string
+		want   bool
+	}{
+		{name: "present", policy: policy, tag: "prod", want: true},
+		{name: "mixed case", policy: policy, tag: "Team-A", want: true},
+		{name: "absent", policy: policy, tag: "staging", want: false},
+		{name: "no tags", policy: dtrack.Policy{}, tag: "prod", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policyHasTag(tt.policy, tt.tag); got != tt.want {
+				t.Errorf("policyHasTag(%q) = %v, want %v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -57,6 +58,8 @@ type PortfolioMetricsDataSourceModel struct {
 	PolicyViolationsOperationalTotal     types.Int64   `tfsdk:"policy_violations_operational_total"`
 	PolicyViolationsOperationalAudited   types.Int64   `tfsdk:"policy_violations_operational_audited"`
 	PolicyViolationsOperationalUnaudited types.Int64   `tfsdk:"policy_violations_operational_unaudited"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *PortfolioMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -67,8 +70,8 @@ func (d *PortfolioMetricsDataSource) Schema(ctx context.Context, req datasource.
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Retrieves the most recent portfolio-wide metrics snapshot from Dependency-Track. " +
 			"If the server has never computed portfolio metrics (possible on a freshly installed v4 instance), " +
-			"this data source triggers a metrics refresh, waits briefly for it to complete, and reports zero " +
-			"values if metrics are still unavailable.",
+			"this data source triggers a metrics refresh, waits for it to complete, and reports zero " +
+			"values if metrics are still unavailable when the read timeout (60 seconds unless set in `timeouts`) runs out.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -204,6 +207,10 @@ func (d *PortfolioMetricsDataSource) Schema(ctx context.Context, req datasource.
 				MarkdownDescription: "Number of unaudited operational policy violations",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
 	}
 }
 
@@ -234,6 +241,15 @@ func (d *PortfolioMetricsDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, metricsRefreshPollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	metrics, _, err := currentMetricsWithRefresh(ctx, d.data.Client.Metrics.LatestPortfolioMetrics, d.data.Client.Metrics.RefreshPortfolioMetrics)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read portfolio metrics, got error: %s", err))
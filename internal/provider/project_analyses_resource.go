@@ -0,0 +1,534 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectAnalysesResource{}
+var _ resource.ResourceWithImportState = &ProjectAnalysesResource{}
+
+func NewProjectAnalysesResource() resource.Resource {
+	return &ProjectAnalysesResource{}
+}
+
+// ProjectAnalysesResource defines the resource implementation.
+type ProjectAnalysesResource struct {
+	data *Data
+}
+
+// ProjectAnalysesResourceModel describes the resource data model.
+type ProjectAnalysesResourceModel struct {
+	ID       types.String           `tfsdk:"id"`
+	Project  types.String           `tfsdk:"project"`
+	Analyses []ProjectAnalysisModel `tfsdk:"analyses"`
+}
+
+// ProjectAnalysisModel describes the analysis decision of one finding.
+type ProjectAnalysisModel struct {
+	Component     types.String `tfsdk:"component"`
+	Vulnerability types.String `tfsdk:"vulnerability"`
+	State         types.String `tfsdk:"state"`
+	Justification types.String `tfsdk:"justification"`
+	Response      types.String `tfsdk:"response"`
+	Details       types.String `tfsdk:"details"`
+	Suppressed    types.Bool   `tfsdk:"suppressed"`
+}
+
+// projectAnalysisRequest is the body of PUT /api/v1/analysis. client-go's
+// AnalysisRequest omits empty details, which makes clearing them impossible.
+type projectAnalysisRequest struct {
+	Project       uuid.UUID `json:"project"`
+	Component     uuid.UUID `json:"component"`
+	Vulnerability uuid.UUID `json:"vulnerability"`
+	State         string    `json:"analysisState"`
+	Justification string    `json:"analysisJustification"`
+	Response      string    `json:"analysisResponse"`
+	Details       string    `json:"analysisDetails"`
+	Suppressed    bool      `json:"isSuppressed"`
+}
+
+var (
+	analysisStates = []string{
+		string(dtrack.AnalysisStateExploitable),
+		string(dtrack.AnalysisStateFalsePositive),
+		string(dtrack.AnalysisStateInTriage),
+		string(dtrack.AnalysisStateNotAffected),
+		string(dtrack.AnalysisStateNotSet),
+		string(dtrack.AnalysisStateResolved),
+	}
+	analysisJustifications = []string{
+		string(dtrack.AnalysisJustificationCodeNotPresent),
+		string(dtrack.AnalysisJustificationCodeNotReachable),
+		string(dtrack.AnalysisJustificationNotSet),
+		string(dtrack.AnalysisJustificationProtectedAtPerimeter),
+		string(dtrack.AnalysisJustificationProtectedAtRuntime),
+		string(dtrack.AnalysisJustificationProtectedByCompiler),
+		string(dtrack.AnalysisJustificationProtectedByMitigatingControl),
+		string(dtrack.AnalysisJustificationRequiresConfiguration),
+		string(dtrack.AnalysisJustificationRequiresDependency),
+		string(dtrack.AnalysisJustificationRequiresEnvironment),
+	}
+	analysisResponses = []string{
+		string(dtrack.AnalysisResponseCanNotFix),
+		string(dtrack.AnalysisResponseNotSet),
+		string(dtrack.AnalysisResponseRollback),
+		string(dtrack.AnalysisResponseUpdate),
+		string(dtrack.AnalysisResponseWillNotFix),
+		string(dtrack.AnalysisResponseWorkaroundAvailable),
+	}
+)
+
+func (r *ProjectAnalysesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_analyses"
+}
+
+func (r *ProjectAnalysesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the analysis decisions (audit state, justification, response and suppression) of a project's findings in bulk, " +
+			"e.g. to migrate suppressions between projects or keep VEX-equivalent decisions in code. " +
+			"Only the listed findings are managed: removing an entry, or destroying the resource, resets its analysis to `NOT_SET` and unsuppresses it. " +
+			"Importing by project UUID adopts every finding that has an analysis state other than `NOT_SET` or is suppressed. " +
+			"The `dependencytrack_project_findings` data source lists the component and vulnerability UUIDs of a project's findings.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource (the project UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the project. Changing this forces a new resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"analyses": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "Analysis decisions, one per finding. Each combination of `component` and `vulnerability` may only appear once.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"component": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The UUID of the affected component",
+						},
+						"vulnerability": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The UUID of the vulnerability",
+						},
+						"state": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The analysis state: " + strings.Join(analysisStates, ", "),
+							Validators: []validator.String{
+								stringvalidator.OneOf(analysisStates...),
+							},
+						},
+						"justification": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(dtrack.AnalysisJustificationNotSet)),
+							MarkdownDescription: "The justification for a `NOT_AFFECTED` state: " + strings.Join(analysisJustifications, ", ") + ". Defaults to `NOT_SET`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(analysisJustifications...),
+							},
+						},
+						"response": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(dtrack.AnalysisResponseNotSet)),
+							MarkdownDescription: "The vendor response: " + strings.Join(analysisResponses, ", ") + ". Defaults to `NOT_SET`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(analysisResponses...),
+							},
+						},
+						"details": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Free-text details of the analysis",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"suppressed": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							MarkdownDescription: "Whether the finding is suppressed. Defaults to `false`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectAnalysesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *ProjectAnalysesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectAnalysesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	data.Analyses = r.apply(ctx, projectUUID, data.Analyses, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(projectUUID.String())
+
+	tflog.Trace(ctx, "created a project analyses resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAnalysesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectAnalysesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	// Analyses are only found by their project, component and vulnerability,
+	// and Dependency-Track answers 404 both for a missing analysis and a
+	// missing project, so the project is checked on its own.
+	if _, err := r.data.Client.Project.Get(ctx, projectUUID); err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	// A null list means the resource was just imported.
+	analyses := data.Analyses
+	if analyses == nil {
+		analyses, err = r.analyzedFindings(ctx, projectUUID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project findings, got error: %s", err))
+			return
+		}
+	}
+
+	for i := range analyses {
+		if err := r.readAnalysis(ctx, projectUUID, &analyses[i]); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read analysis, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(projectUUID.String())
+	data.Analyses = analyses
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectAnalysesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectAnalysesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(plan.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	plan.Analyses = r.apply(ctx, projectUUID, plan.Analyses, state.Analyses, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(projectUUID.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectAnalysesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectAnalysesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	for _, analysis := range data.Analyses {
+		if err := r.putAnalysis(ctx, projectUUID, resetAnalysis(analysis)); err != nil {
+			if isNotFound(err) {
+				// The project, component or vulnerability is gone, and the
+				// analysis with it.
+				continue
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset analysis, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted a project analyses resource")
+}
+
+func (r *ProjectAnalysesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectUUID, err := uuid.Parse(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to parse UUID. Expected a valid project UUID, got: %s\nError: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectUUID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), projectUUID.String())...)
+}
+
+// apply writes the analyses of desired that differ from prior, resets the
+// analyses of prior that are no longer desired and returns desired as read
+// back from the server.
+func (r *ProjectAnalysesResource) apply(ctx context.Context, projectUUID uuid.UUID, desired, prior []ProjectAnalysisModel, diags *diag.Diagnostics) []ProjectAnalysisModel {
+	if duplicates := duplicateAnalysisKeys(desired); len(duplicates) > 0 {
+		diags.AddAttributeError(
+			path.Root("analyses"),
+			"Duplicate Analyses",
+			fmt.Sprintf("The following component/vulnerability combinations are listed more than once: %s.", strings.Join(duplicates, ", ")),
+		)
+		return nil
+	}
+
+	priorByKey := make(map[string]ProjectAnalysisModel, len(prior))
+	for _, analysis := range prior {
+		priorByKey[analysisKey(analysis)] = analysis
+	}
+
+	for _, analysis := range desired {
+		key := analysisKey(analysis)
+		if existing, ok := priorByKey[key]; ok && analysesEqual(existing, analysis) {
+			delete(priorByKey, key)
+			continue
+		}
+		delete(priorByKey, key)
+
+		if err := r.putAnalysis(ctx, projectUUID, analysis); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update analysis of %s, got error: %s", key, err))
+			return nil
+		}
+	}
+
+	// Analyses left over were removed from the configuration.
+	for _, analysis := range prior {
+		if _, ok := priorByKey[analysisKey(analysis)]; !ok {
+			continue
+		}
+		if err := r.putAnalysis(ctx, projectUUID, resetAnalysis(analysis)); err != nil && !isNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to reset analysis of %s, got error: %s", analysisKey(analysis), err))
+			return nil
+		}
+	}
+
+	result := slices.Clone(desired)
+	for i := range result {
+		if err := r.readAnalysis(ctx, projectUUID, &result[i]); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read analysis, got error: %s", err))
+			return nil
+		}
+	}
+	return result
+}
+
+// putAnalysis writes analysis for the project.
+func (r *ProjectAnalysesResource) putAnalysis(ctx context.Context, projectUUID uuid.UUID, analysis ProjectAnalysisModel) error {
+	componentUUID, err := uuid.Parse(analysis.Component.ValueString())
+	if err != nil {
+		return fmt.Errorf("invalid component UUID: %w", err)
+	}
+	vulnerabilityUUID, err := uuid.Parse(analysis.Vulnerability.ValueString())
+	if err != nil {
+		return fmt.Errorf("invalid vulnerability UUID: %w", err)
+	}
+
+	return r.data.API().Do(ctx, http.MethodPut, "/api/v1/analysis", projectAnalysisRequest{
+		Project:       projectUUID,
+		Component:     componentUUID,
+		Vulnerability: vulnerabilityUUID,
+		State:         analysis.State.ValueString(),
+		Justification: analysis.Justification.ValueString(),
+		Response:      analysis.Response.ValueString(),
+		Details:       analysis.Details.ValueString(),
+		Suppressed:    analysis.Suppressed.ValueBool(),
+	}, nil)
+}
+
+// readAnalysis updates analysis with the decision stored on the server.
+// Findings that were never analyzed read as NOT_SET and unsuppressed.
+func (r *ProjectAnalysesResource) readAnalysis(ctx context.Context, projectUUID uuid.UUID, analysis *ProjectAnalysisModel) error {
+	componentUUID, err := uuid.Parse(analysis.Component.ValueString())
+	if err != nil {
+		return fmt.Errorf("invalid component UUID: %w", err)
+	}
+	vulnerabilityUUID, err := uuid.Parse(analysis.Vulnerability.ValueString())
+	if err != nil {
+		return fmt.Errorf("invalid vulnerability UUID: %w", err)
+	}
+
+	stored, err := r.data.Client.Analysis.Get(ctx, componentUUID, projectUUID, vulnerabilityUUID)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	analysis.State = types.StringValue(stringOrDefault(string(stored.State), string(dtrack.AnalysisStateNotSet)))
+	analysis.Justification = types.StringValue(stringOrDefault(string(stored.Justification), string(dtrack.AnalysisJustificationNotSet)))
+	analysis.Response = types.StringValue(stringOrDefault(string(stored.Response), string(dtrack.AnalysisResponseNotSet)))
+	analysis.Details = stringValueOrNull(stored.Details)
+	analysis.Suppressed = types.BoolValue(stored.Suppressed)
+	return nil
+}
+
+// analyzedFindings returns the findings of the project that have an analysis
+// state other than NOT_SET or are suppressed, sorted by component and
+// vulnerability. Only their keys are set; see readAnalysis.
+func (r *ProjectAnalysesResource) analyzedFindings(ctx context.Context, projectUUID uuid.UUID) ([]ProjectAnalysisModel, error) {
+	findings, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error) {
+		return r.data.Client.Finding.GetAll(ctx, projectUUID, true, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	analyses := []ProjectAnalysisModel{}
+	for _, f := range findings {
+		if (f.Analysis.State == "" || f.Analysis.State == string(dtrack.AnalysisStateNotSet)) && !f.Analysis.Suppressed {
+			continue
+		}
+		analyses = append(analyses, ProjectAnalysisModel{
+			Component:     types.StringValue(f.Component.UUID.String()),
+			Vulnerability: types.StringValue(f.Vulnerability.UUID.String()),
+		})
+	}
+	slices.SortFunc(analyses, func(a, b ProjectAnalysisModel) int {
+		return strings.Compare(analysisKey(a), analysisKey(b))
+	})
+	return analyses, nil
+}
+
+// analysisKey identifies the finding an analysis belongs to.
+func analysisKey(analysis ProjectAnalysisModel) string {
+	return analysis.Component.ValueString() + "/" + analysis.Vulnerability.ValueString()
+}
+
+// analysesEqual reports whether a and b hold the same decision.
+func analysesEqual(a, b ProjectAnalysisModel) bool {
+	return a.Component.Equal(b.Component) &&
+		a.Vulnerability.Equal(b.Vulnerability) &&
+		a.State.Equal(b.State) &&
+		a.Justification.Equal(b.Justification) &&
+		a.Response.Equal(b.Response) &&
+		a.Details.Equal(b.Details) &&
+		a.Suppressed.Equal(b.Suppressed)
+}
+
+// duplicateAnalysisKeys returns the keys listed more than once, sorted.
+func duplicateAnalysisKeys(analyses []ProjectAnalysisModel) []string {
+	seen := make(map[string]bool, len(analyses))
+	var duplicates []string
+	for _, analysis := range analyses {
+		key := analysisKey(analysis)
+		if seen[key] && !slices.Contains(duplicates, key) {
+			duplicates = append(duplicates, key)
+		}
+		seen[key] = true
+	}
+	slices.Sort(duplicates)
+	return duplicates
+}
+
+// resetAnalysis returns the analysis of the same finding with every decision
+// cleared.
+func resetAnalysis(analysis ProjectAnalysisModel) ProjectAnalysisModel {
+	return ProjectAnalysisModel{
+		Component:     analysis.Component,
+		Vulnerability: analysis.Vulnerability,
+		State:         types.StringValue(string(dtrack.AnalysisStateNotSet)),
+		Justification: types.StringValue(string(dtrack.AnalysisJustificationNotSet)),
+		Response:      types.StringValue(string(dtrack.AnalysisResponseNotSet)),
+		Details:       types.StringNull(),
+		Suppressed:    types.BoolValue(false),
+	}
+}
+
+// stringOrDefault returns s, or def if s is empty.
+func stringOrDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
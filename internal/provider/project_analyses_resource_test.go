@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectAnalysesResource(t *testing.T) {
+	projectUUID := testAccSeedProjectWithFinding(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAnalysesResourceConfig(projectUUID, `
+    state         = "NOT_AFFECTED"
+    justification = "CODE_NOT_REACHABLE"
+    details       = "Only used in tests"
+    suppressed    = true`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_analyses.test",
+						tfjsonpath.New("analyses").AtSliceIndex(0),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"state":         knownvalue.StringExact("NOT_AFFECTED"),
+							"justification": knownvalue.StringExact("CODE_NOT_REACHABLE"),
+							"response":      knownvalue.StringExact("NOT_SET"),
+							"details":       knownvalue.StringExact("Only used in tests"),
+							"suppressed":    knownvalue.Bool(true),
+						}),
+					),
+				},
+			},
+			// Import adopts the analyzed findings of the project
+			{
+				ResourceName:      "dependencytrack_project_analyses.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update clears the details and unsuppresses
+			{
+				Config: testAccProjectAnalysesResourceConfig(projectUUID, `
+    state    = "EXPLOITABLE"
+    response = "UPDATE"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_analyses.test",
+						tfjsonpath.New("analyses").AtSliceIndex(0),
+						knownvalue.ObjectPartial(map[string]knownvalue.Check{
+							"state":         knownvalue.StringExact("EXPLOITABLE"),
+							"justification": knownvalue.StringExact("NOT_SET"),
+							"response":      knownvalue.StringExact("UPDATE"),
+							"details":       knownvalue.Null(),
+							"suppressed":    knownvalue.Bool(false),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectAnalysesResourceConfig(projectUUID, decision string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+data "dependencytrack_project_findings" "test" {
+  project    = %[1]q
+  suppressed = true
+}
+
+resource "dependencytrack_project_analyses" "test" {
+  project = %[1]q
+
+  analyses = [for finding in data.dependencytrack_project_findings.test.findings : {
+    component     = finding.component_uuid
+    vulnerability = finding.vulnerability_uuid
+%[2]s
+  }]
+}
+`, projectUUID, decision)
+}
+
+func TestDuplicateAnalysisKeys(t *testing.T) {
+	analysis := func(component, vulnerability string) ProjectAnalysisModel {
+		return ProjectAnalysisModel{
+			Component:     types.StringValue(component),
+			Vulnerability: types.StringValue(vulnerability),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		analyses []ProjectAnalysisModel
+		want     []string
+	}{
+		{name: "none", analyses: nil},
+		{name: "distinct", analyses: []ProjectAnalysisModel{analysis("c1", "v1"), analysis("c1", "v2"), analysis("c2", "v1")}},
+		{
+			name:     "duplicates reported once",
+			analyses: []ProjectAnalysisModel{analysis("c2", "v1"), analysis("c1", "v1"), analysis("c2", "v1"), analysis("c1", "v1"), analysis("c2", "v1")},
+			want:     []string{"c1/v1", "c2/v1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := duplicateAnalysisKeys(tt.analyses); !slices.Equal(got, tt.want) {
+				t.Errorf("duplicateAnalysisKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectBOMDataSource{}
+
+func NewProjectBOMDataSource() datasource.DataSource {
+	return &ProjectBOMDataSource{}
+}
+
+// ProjectBOMDataSource defines the data source implementation.
+type ProjectBOMDataSource struct {
+	data *Data
+}
+
+// ProjectBOMDataSourceModel describes the data source data model.
+type ProjectBOMDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Project types.String `tfsdk:"project"`
+	Format  types.String `tfsdk:"format"`
+	Variant types.String `tfsdk:"variant"`
+	BOM     types.String `tfsdk:"bom"`
+}
+
+func (d *ProjectBOMDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_bom"
+}
+
+func (d *ProjectBOMDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the current CycloneDX BOM of a Dependency-Track project, e.g. to archive it through another provider. " +
+			"The whole document is stored in Terraform state, so prefer this data source for projects of moderate size.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the export in the format `project_uuid/format/variant`",
+			},
+			"project": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the project to export",
+			},
+			"format": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The CycloneDX serialization to export, `JSON` or `XML`. Defaults to `JSON`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(dtrack.BOMFormatJSON), string(dtrack.BOMFormatXML)),
+				},
+			},
+			"variant": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "What to export: `inventory` (the components and services, the default), " +
+					"`withVulnerabilities` (the inventory plus its vulnerabilities and their analyses, i.e. a BOM with embedded VEX) " +
+					"or `vdr` (a vulnerability disclosure report).",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(dtrack.BOMVariantInventory), string(dtrack.BOMVariantWithVulnerabilities), string(dtrack.BOMVariantVDR)),
+				},
+			},
+			"bom": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The exported CycloneDX document",
+			},
+		},
+	}
+}
+
+func (d *ProjectBOMDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *ProjectBOMDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectBOMDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	// Dependency-Track itself exports XML when no format is given; JSON is
+	// the more useful default for further processing in Terraform.
+	if data.Format.IsNull() {
+		data.Format = types.StringValue(string(dtrack.BOMFormatJSON))
+	}
+	if data.Variant.IsNull() {
+		data.Variant = types.StringValue(string(dtrack.BOMVariantInventory))
+	}
+
+	bom, err := d.data.Client.BOM.ExportProject(ctx, projectUUID, dtrack.BOMFormat(data.Format.ValueString()), dtrack.BOMVariant(data.Variant.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export project BOM, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", projectUUID, data.Format.ValueString(), data.Variant.ValueString()))
+	data.BOM = types.StringValue(bom)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
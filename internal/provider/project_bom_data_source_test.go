@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectBOMDataSource(t *testing.T) {
+	projectUUID := testAccSeedProjectWithComponent(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectBOMDataSourceConfig(projectUUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_bom.json",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(projectUUID+"/JSON/inventory"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_bom.json",
+						tfjsonpath.New("bom"),
+						knownvalue.StringRegexp(regexp.MustCompile(`"bomFormat"\s*:\s*"CycloneDX"(?s:.*)pkg:maven/org\.example/tf-acc-component@2\.0\.1`)),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_bom.xml",
+						tfjsonpath.New("bom"),
+						knownvalue.StringRegexp(regexp.MustCompile(`<bom (?s:.*)tf-acc-component`)),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectBOMDataSourceConfig(projectUUID string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+data "dependencytrack_project_bom" "json" {
+  project = %[1]q
+}
+
+data "dependencytrack_project_bom" "xml" {
+  project = %[1]q
+  format  = "XML"
+  variant = "withVulnerabilities"
+}
+`, projectUUID)
+}
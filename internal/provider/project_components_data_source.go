@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectComponentsDataSource{}
+
+func NewProjectComponentsDataSource() datasource.DataSource {
+	return &ProjectComponentsDataSource{}
+}
+
+// ProjectComponentsDataSource defines the data source implementation.
+type ProjectComponentsDataSource struct {
+	data *Data
+}
+
+// ProjectComponentsDataSourceModel describes the data source data model.
+type ProjectComponentsDataSourceModel struct {
+	ID         types.String            `tfsdk:"id"`
+	Project    types.String            `tfsdk:"project"`
+	Components []ProjectComponentModel `tfsdk:"components"`
+}
+
+// ProjectComponentModel describes an individual component.
+type ProjectComponentModel struct {
+	UUID    types.String `tfsdk:"uuid"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+	Group   types.String `tfsdk:"group"`
+	PURL    types.String `tfsdk:"purl"`
+}
+
+func (d *ProjectComponentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_components"
+}
+
+func (d *ProjectComponentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the components of a project from Dependency-Track.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this data source result (the project UUID)",
+			},
+			"project": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the project",
+			},
+			"components": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of components",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the component",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the component",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the component",
+						},
+						"group": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The group (namespace) of the component",
+						},
+						"purl": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The package URL of the component",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectComponentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *ProjectComponentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectComponentsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	components, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Component], error) {
+		return d.data.Client.Component.GetAll(ctx, projectUUID, po, dtrack.ComponentFilterOptions{})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project components, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(projectUUID.String())
+	data.Components = make([]ProjectComponentModel, 0, len(components))
+	for i := range components {
+		c := &components[i]
+		data.Components = append(data.Components, ProjectComponentModel{
+			UUID:    types.StringValue(c.UUID.String()),
+			Name:    types.StringValue(c.Name),
+			Version: types.StringValue(c.Version),
+			Group:   types.StringValue(c.Group),
+			PURL:    types.StringValue(c.PURL),
+		})
+	}
+
+	tflog.Trace(ctx, "read a project components data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectComponentsDataSource(t *testing.T) {
+	projectUUID := testAccSeedProjectWithComponent(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectComponentsDataSourceConfig(projectUUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(projectUUID),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("components"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("components").AtSliceIndex(0).AtMapKey("name"),
+						knownvalue.StringExact("tf-acc-component"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("components").AtSliceIndex(0).AtMapKey("version"),
+						knownvalue.StringExact("2.0.1"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("components").AtSliceIndex(0).AtMapKey("group"),
+						knownvalue.StringExact("org.example"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("components").AtSliceIndex(0).AtMapKey("purl"),
+						knownvalue.StringExact("pkg:maven/org.example/tf-acc-component@2.0.1"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("components").AtSliceIndex(0).AtMapKey("uuid"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+// TestAccProjectComponentsDataSource_Empty verifies the data source returns an
+// empty list for a project without components.
+func TestAccProjectComponentsDataSource_Empty(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectComponentsDataSourceEmptyConfig(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_components.test",
+						tfjsonpath.New("components"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectComponentsDataSourceConfig(projectUUID string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+data "dependencytrack_project_components" "test" {
+  project = %q
+}
+`, projectUUID)
+}
+
+func testAccProjectComponentsDataSourceEmptyConfig(suffix string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "tf-acc-no-components-%s"
+  version = "1.0.0"
+}
+
+data "dependencytrack_project_components" "test" {
+  project = dependencytrack_project.test.id
+}
+`, suffix)
+}
@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -38,6 +43,7 @@ type ProjectDataSourceModel struct {
 	PURL        types.String `tfsdk:"purl"`
 	SWIDTagID   types.String `tfsdk:"swid_tag_id"`
 	ParentUUID  types.String `tfsdk:"parent_uuid"`
+	Children    types.Set    `tfsdk:"children"`
 }
 
 func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -46,23 +52,23 @@ func (d *ProjectDataSource) Metadata(ctx context.Context, req datasource.Metadat
 
 func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Retrieves information about a Dependency-Track project. You can look up a project by ID (UUID), or by name and version.",
+		MarkdownDescription: "Retrieves information about a Dependency-Track project. You can look up a project by ID (UUID), by name and version, or by Package URL (PURL).",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The UUID of the project. Either `id` or both `name` and `version` must be specified.",
+				MarkdownDescription: "The UUID of the project. Either `id`, both `name` and `version`, or `purl` must be specified.",
 			},
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The name of the project. Required when `id` is not specified.",
+				MarkdownDescription: "The name of the project. Required when neither `id` nor `purl` is specified.",
 			},
 			"version": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The version of the project. Required when `id` is not specified.",
+				MarkdownDescription: "The version of the project. Required when neither `id` nor `purl` is specified.",
 			},
 			"description": schema.StringAttribute{
 				Computed:            true,
@@ -93,8 +99,14 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The Common Platform Enumeration (CPE) of the project",
 			},
 			"purl": schema.StringAttribute{
-				Computed:            true,
-				MarkdownDescription: "The Package URL (PURL) of the project",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The Package URL (PURL) of the project, e.g. the PURL of the `metadata.component` of its SBOM. " +
+					"When specified, the project with exactly this PURL is looked up; Dependency-Track has no PURL query for projects, so all projects are scanned. " +
+					"Fails when several projects share the PURL, e.g. a PURL without a version.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id"), path.MatchRoot("name"), path.MatchRoot("version")),
+				},
 			},
 			"swid_tag_id": schema.StringAttribute{
 				Computed:            true,
@@ -104,6 +116,11 @@ func (d *ProjectDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				Computed:            true,
 				MarkdownDescription: "The UUID of the parent project",
 			},
+			"children": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The UUIDs of the project's direct children. Grandchildren are not included.",
+			},
 		},
 	}
 }
@@ -142,6 +159,7 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	hasID := !data.ID.IsNull() && !data.ID.IsUnknown()
 	hasName := !data.Name.IsNull() && !data.Name.IsUnknown()
 	hasVersion := !data.Version.IsNull() && !data.Version.IsUnknown()
+	hasPURL := !data.PURL.IsNull() && !data.PURL.IsUnknown()
 
 	if hasID {
 		// Lookup by ID (UUID)
@@ -163,10 +181,16 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to lookup project, got error: %s", err))
 			return
 		}
+	} else if hasPURL {
+		var found bool
+		project, found = d.findProjectByPURL(ctx, data.PURL.ValueString(), &resp.Diagnostics)
+		if !found {
+			return
+		}
 	} else {
 		resp.Diagnostics.AddError(
 			"Invalid Configuration",
-			"Either 'id' or both 'name' and 'version' must be specified.",
+			"Either 'id', both 'name' and 'version', or 'purl' must be specified.",
 		)
 		return
 	}
@@ -199,5 +223,61 @@ func (d *ProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.ParentUUID = types.StringNull()
 	}
 
+	children, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+		return d.data.Client.Project.GetChildren(ctx, project.UUID, po)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list children of project, got error: %s", err))
+		return
+	}
+
+	childUUIDs := make([]string, 0, len(children))
+	for _, child := range children {
+		childUUIDs = append(childUUIDs, child.UUID.String())
+	}
+
+	childSet, diags := types.SetValueFrom(ctx, types.StringType, childUUIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Children = childSet
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// findProjectByPURL returns the only project whose PURL is purl. It adds an
+// error and returns false when there is no such project or more than one.
+func (d *ProjectDataSource) findProjectByPURL(ctx context.Context, purl string, diags *diag.Diagnostics) (dtrack.Project, bool) {
+	projects, err := fetchAllPages(ctx, d.data.Client.Project.GetAll)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read projects, got error: %s", err))
+		return dtrack.Project{}, false
+	}
+
+	var matches []dtrack.Project
+	for _, project := range projects {
+		if project.PURL == purl {
+			matches = append(matches, project)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError("Project Not Found", fmt.Sprintf("No project found with PURL: %s", purl))
+		return dtrack.Project{}, false
+	case 1:
+		return matches[0], true
+	}
+
+	versions := make([]string, 0, len(matches))
+	for _, project := range matches {
+		versions = append(versions, fmt.Sprintf("%s %s (%s)", project.Name, project.Version, project.UUID))
+	}
+	diags.AddError(
+		"Multiple Projects Found",
+		fmt.Sprintf("%d projects have the PURL %s: %s. Use a PURL that includes the version, or look the project up by name and version.",
+			len(matches), purl, strings.Join(versions, ", ")),
+	)
+	return dtrack.Project{}, false
+}
@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -101,3 +103,110 @@ data "dependencytrack_project" "test" {
   version = dependencytrack_project.test.version
 }
 `
+
+func TestAccProjectDataSource_ByPURL(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectDataSourceConfigByPURL(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("PURL Lookup Test Project "+suffix),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project.test",
+						tfjsonpath.New("version"),
+						knownvalue.StringExact("3.0.0"),
+					),
+				},
+			},
+			{
+				Config: testAccProjectDataSourceConfigByPURL(suffix) + fmt.Sprintf(`
+data "dependencytrack_project" "missing" {
+  purl = "pkg:generic/tf-acc-missing-%s@1.0.0"
+}
+`, suffix),
+				ExpectError: regexp.MustCompile(`Project Not Found`),
+			},
+		},
+	})
+}
+
+func testAccProjectDataSourceConfigByPURL(suffix string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "PURL Lookup Test Project %[1]s"
+  version = "3.0.0"
+  purl    = "pkg:generic/tf-acc-purl-lookup-%[1]s@3.0.0"
+}
+
+data "dependencytrack_project" "test" {
+  purl = dependencytrack_project.test.purl
+}
+`, suffix)
+}
+
+func TestAccProjectDataSource_Children(t *testing.T) {
+	suffix := randomSuffix()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectDataSourceConfigChildren(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					// Only direct children are listed, not the grandchild.
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project.parent",
+						tfjsonpath.New("children"),
+						knownvalue.SetSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project.leaf",
+						tfjsonpath.New("children"),
+						knownvalue.SetExact([]knownvalue.Check{}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectDataSourceConfigChildren(suffix string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "parent" {
+  name    = "Children Parent %[1]s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project" "child" {
+  for_each = toset(["a", "b"])
+
+  name        = "Children Child ${each.key} %[1]s"
+  version     = "1.0.0"
+  parent_uuid = dependencytrack_project.parent.id
+}
+
+resource "dependencytrack_project" "grandchild" {
+  name        = "Children Grandchild %[1]s"
+  version     = "1.0.0"
+  parent_uuid = dependencytrack_project.child["a"].id
+}
+
+data "dependencytrack_project" "parent" {
+  id = dependencytrack_project.parent.id
+
+  depends_on = [dependencytrack_project.child, dependencytrack_project.grandchild]
+}
+
+data "dependencytrack_project" "leaf" {
+  id = dependencytrack_project.grandchild.id
+}
+`, suffix)
+}
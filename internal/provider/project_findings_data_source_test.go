@@ -105,6 +105,46 @@ func TestAccProjectFindingsDataSource_Empty(t *testing.T) {
 	})
 }
 
+// TestAccProjectFindingsDataSource_Suppressed verifies that suppressed
+// findings are left out by default and only returned when suppressed = true,
+// together with their analysis state.
+func TestAccProjectFindingsDataSource_Suppressed(t *testing.T) {
+	projectUUID := testAccSeedProjectWithFinding(t)
+	testAccSuppressProjectFindings(t, projectUUID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectFindingsDataSourceSuppressedConfig(projectUUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_findings.unsuppressed",
+						tfjsonpath.New("findings"),
+						knownvalue.ListSizeExact(0),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_findings.all",
+						tfjsonpath.New("findings"),
+						knownvalue.ListSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_findings.all",
+						tfjsonpath.New("findings").AtSliceIndex(0).AtMapKey("is_suppressed"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_findings.all",
+						tfjsonpath.New("findings").AtSliceIndex(0).AtMapKey("analysis_state"),
+						knownvalue.StringExact("FALSE_POSITIVE"),
+					),
+				},
+			},
+		},
+	})
+}
+
 func testAccProjectFindingsDataSourceConfig(projectUUID string) string {
 	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
 data "dependencytrack_project_findings" "test" {
@@ -125,3 +165,16 @@ data "dependencytrack_project_findings" "test" {
 }
 `, suffix)
 }
+
+func testAccProjectFindingsDataSourceSuppressedConfig(projectUUID string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+data "dependencytrack_project_findings" "unsuppressed" {
+  project = %[1]q
+}
+
+data "dependencytrack_project_findings" "all" {
+  project    = %[1]q
+  suppressed = true
+}
+`, projectUUID)
+}
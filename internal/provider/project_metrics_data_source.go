@@ -6,6 +6,7 @@ import (
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -58,6 +59,8 @@ type ProjectMetricsDataSourceModel struct {
 	PolicyViolationsOperationalTotal     types.Int64   `tfsdk:"policy_violations_operational_total"`
 	PolicyViolationsOperationalAudited   types.Int64   `tfsdk:"policy_violations_operational_audited"`
 	PolicyViolationsOperationalUnaudited types.Int64   `tfsdk:"policy_violations_operational_unaudited"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (d *ProjectMetricsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,8 +71,8 @@ func (d *ProjectMetricsDataSource) Schema(ctx context.Context, req datasource.Sc
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Retrieves the most recent metrics snapshot for a project from Dependency-Track. " +
 			"If the server has never computed metrics for the project (typical for a freshly created project on v4), " +
-			"this data source triggers a metrics refresh, waits briefly for it to complete, and reports zero " +
-			"values if metrics are still unavailable.",
+			"this data source triggers a metrics refresh, waits for it to complete, and reports zero " +
+			"values if metrics are still unavailable when the read timeout (60 seconds unless set in `timeouts`) runs out.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -201,6 +204,10 @@ func (d *ProjectMetricsDataSource) Schema(ctx context.Context, req datasource.Sc
 				MarkdownDescription: "Number of unaudited operational policy violations",
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
 	}
 }
 
@@ -231,6 +238,15 @@ func (d *ProjectMetricsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, metricsRefreshPollTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	projectUUID, err := uuid.Parse(data.Project.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
@@ -3,9 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
-	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,6 +16,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectPolicyResource{}
 var _ resource.ResourceWithImportState = &ProjectPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectPolicyResource{}
 
 func NewProjectPolicyResource() resource.Resource {
 	return &ProjectPolicyResource{}
@@ -41,7 +40,9 @@ func (r *ProjectPolicyResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *ProjectPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages the assignment of a policy to a project in Dependency-Track. This resource creates a relationship between a project and a policy, enabling policy enforcement for that specific project.",
+		MarkdownDescription: "Manages the assignment of a policy to a project in Dependency-Track. This resource creates a relationship between a project and a policy, enabling policy enforcement for that specific project. " +
+			"A policy without project or tag assignments is global and applies to every project; assigning a project limits it to the assigned projects (and tags). " +
+			"Dependency-Track has no way to exclude projects from a global policy, so to leave out a few projects, assign the policy to all the others or scope it with a tag (see `dependencytrack_policy_tag`).",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -87,6 +88,40 @@ func (r *ProjectPolicyResource) Configure(ctx context.Context, req resource.Conf
 	r.data = data
 }
 
+func (r *ProjectPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only the first assignment of an existing policy changes its scope.
+	if !req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.data == nil {
+		return
+	}
+
+	var plan ProjectPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Policy.IsUnknown() {
+		return
+	}
+
+	policyUUID, err := uuid.Parse(plan.Policy.ValueString())
+	if err != nil {
+		return
+	}
+
+	policy, err := r.data.Client.Policy.Get(ctx, policyUUID)
+	if err != nil {
+		// Only a warning depends on the lookup; Create reports real errors.
+		return
+	}
+
+	if len(policy.Projects) == 0 && len(policy.Tags) == 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("policy"),
+			"Policy Will No Longer Be Global",
+			fmt.Sprintf("Policy %q currently applies to all projects. Assigning a project to it limits it to its assigned projects, "+
+				"so it stops applying to every other project. Dependency-Track cannot exclude projects from a global policy; "+
+				"if that was the intent, assign the policy to the projects it should cover instead.", policy.Name),
+		)
+	}
+}
+
 func (r *ProjectPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ProjectPolicyResourceModel
 
@@ -108,7 +143,9 @@ func (r *ProjectPolicyResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	_, err = r.data.Client.Policy.AddProject(ctx, policyUUID, projectUUID)
+	// Associations added in parallel can collide on the policy and fail with
+	// a transient 409; see withConflictRetry.
+	_, err = r.data.Client.Policy.AddProject(withConflictRetry(ctx), policyUUID, projectUUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add project to policy, got error: %s", err))
 		return
@@ -143,7 +180,7 @@ func (r *ProjectPolicyResource) Read(ctx context.Context, req resource.ReadReque
 	// Get the policy and check if the project is in its projects list
 	policy, err := r.data.Client.Policy.Get(ctx, policyUUID)
 	if err != nil {
-		if apiErr, ok := err.(*dtrack.APIError); ok && apiErr.StatusCode == 404 {
+		if isNotFound(err) {
 			// Policy doesn't exist anymore, remove from state
 			resp.State.RemoveResource(ctx)
 			return
@@ -201,7 +238,7 @@ func (r *ProjectPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	_, err = r.data.Client.Policy.DeleteProject(ctx, policyUUID, projectUUID)
+	_, err = r.data.Client.Policy.DeleteProject(withConflictRetry(ctx), policyUUID, projectUUID)
 	if err != nil {
 		// The policy or project being gone means there is nothing left to delete.
 		if isNotFound(err) {
@@ -214,30 +251,9 @@ func (r *ProjectPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 
 func (r *ProjectPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Import using format: policy_uuid/project_uuid
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected format: policy_uuid/project_uuid, got: %s", req.ID),
-		)
-		return
-	}
-
-	policyUUID, err := uuid.Parse(parts[0])
+	policyUUID, projectUUID, err := parseCompositeUUIDID(req.ID, "policy_uuid", "project_uuid")
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Policy UUID",
-			fmt.Sprintf("Unable to parse policy UUID from import ID: %s\nError: %s", parts[0], err),
-		)
-		return
-	}
-
-	projectUUID, err := uuid.Parse(parts[1])
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Project UUID",
-			fmt.Sprintf("Unable to parse project UUID from import ID: %s\nError: %s", parts[1], err),
-		)
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Unable to parse import ID: %s\nExpected format: policy_uuid/project_uuid", err))
 		return
 	}
 
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -115,3 +116,58 @@ resource "dependencytrack_project_policy" "test2" {
 }
 `
 }
+
+// TestAccProjectPolicyResource_ExistingGlobalPolicy assigns a project to a
+// policy that already exists and is global, which plans with a warning that
+// the policy stops applying to all projects.
+func TestAccProjectPolicyResource_ExistingGlobalPolicy(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPolicyResourceConfigExistingPolicy(suffix, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_policy.test",
+						tfjsonpath.New("global"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			{
+				Config: testAccProjectPolicyResourceConfigExistingPolicy(suffix, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_policy.test[0]",
+						tfjsonpath.New("id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectPolicyResourceConfigExistingPolicy(suffix string, assign bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "tf-acc-scoped-project-%[1]s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_policy" "test" {
+  name            = "tf-acc-global-policy-%[1]s"
+  operator        = "ANY"
+  violation_state = "INFO"
+}
+
+resource "dependencytrack_project_policy" "test" {
+  count = %[2]t ? 1 : 0
+
+  policy  = dependencytrack_policy.test.id
+  project = dependencytrack_project.test.id
+}
+`, suffix, assign)
+}
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectPropertyDataSource{}
+
+func NewProjectPropertyDataSource() datasource.DataSource {
+	return &ProjectPropertyDataSource{}
+}
+
+// ProjectPropertyDataSource defines the data source implementation.
+type ProjectPropertyDataSource struct {
+	data *Data
+}
+
+// ProjectPropertyDataSourceModel describes the data source data model.
+type ProjectPropertyDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Project     types.String `tfsdk:"project"`
+	Group       types.String `tfsdk:"group"`
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *ProjectPropertyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_property"
+}
+
+func (d *ProjectPropertyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a single property of a Dependency-Track project. " +
+			"Values of ENCRYPTEDSTRING properties are never returned in plain text by Dependency-Track; " +
+			"for those properties `value` is always null.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the project property in the format `project_uuid/group/name`",
+			},
+			"project": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the project the property belongs to",
+			},
+			"group": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The group name of the property",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the property",
+			},
+			"value": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The value of the property. Always null for ENCRYPTEDSTRING properties, whose value the server hides behind a placeholder.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the property (BOOLEAN, INTEGER, NUMBER, STRING, ENCRYPTEDSTRING, TIMESTAMP, URL, UUID)",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the property",
+			},
+		},
+	}
+}
+
+func (d *ProjectPropertyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *ProjectPropertyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectPropertyDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	property, found, err := findProjectProperty(ctx, d.data.Client, projectUUID, data.Group.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project property, got error: %s", err))
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError(
+			"Project Property Not Found",
+			fmt.Sprintf("Project %s has no property with group=%q and name=%q.", projectUUID, data.Group.ValueString(), data.Name.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(projectPropertyID(projectUUID, property.Group, property.Name))
+
+	// As with config properties, expose encrypted values as null so the
+	// placeholder string cannot be fed back into Dependency-Track.
+	if property.Type == "ENCRYPTEDSTRING" && property.Value == encryptedStringPlaceholder {
+		data.Value = types.StringNull()
+	} else {
+		data.Value = types.StringValue(property.Value)
+	}
+	data.Type = types.StringValue(property.Type)
+	data.Description = types.StringValue(property.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
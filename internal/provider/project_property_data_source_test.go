@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectPropertyDataSource(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPropertyDataSourceConfig(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_property.test",
+						tfjsonpath.New("value"),
+						knownvalue.StringExact("1234"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_property.test",
+						tfjsonpath.New("type"),
+						knownvalue.StringExact("INTEGER"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_property.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("CI build number"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_property.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringRegexp(regexp.MustCompile(`/build/number$`)),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectPropertyDataSource_NotFound(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "Test Project Property Data Source Missing %s"
+  version = "1.0.0"
+}
+
+data "dependencytrack_project_property" "test" {
+  project = dependencytrack_project.test.id
+  group   = "build"
+  name    = "missing"
+}
+`, suffix),
+				ExpectError: regexp.MustCompile(`Project Property Not Found`),
+			},
+		},
+	})
+}
+
+func testAccProjectPropertyDataSourceConfig(suffix string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "Test Project Property Data Source %s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project_property" "test" {
+  project     = dependencytrack_project.test.id
+  group       = "build"
+  name        = "number"
+  value       = "1234"
+  type        = "INTEGER"
+  description = "CI build number"
+}
+
+data "dependencytrack_project_property" "test" {
+  project = dependencytrack_project_property.test.project
+  group   = dependencytrack_project_property.test.group
+  name    = dependencytrack_project_property.test.name
+}
+`, suffix)
+}
@@ -211,7 +211,7 @@ func (r *ProjectPropertyResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	property, found, err := r.findProperty(ctx, projectUUID, data.Group.ValueString(), data.Name.ValueString())
+	property, found, err := findProjectProperty(ctx, r.data.Client, projectUUID, data.Group.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project property, got error: %s", err))
 		return
@@ -331,11 +331,11 @@ func (r *ProjectPropertyResource) setValue(data *ProjectPropertyResourceModel, p
 	data.Value = types.StringValue(property.Value)
 }
 
-// findProperty returns the project property matching group and name, paging
-// through the project's full property list.
-func (r *ProjectPropertyResource) findProperty(ctx context.Context, projectUUID uuid.UUID, group, name string) (dtrack.ProjectProperty, bool, error) {
+// findProjectProperty returns the project property matching group and name,
+// paging through the project's full property list.
+func findProjectProperty(ctx context.Context, client *dtrack.Client, projectUUID uuid.UUID, group, name string) (dtrack.ProjectProperty, bool, error) {
 	properties, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.ProjectProperty], error) {
-		return r.data.Client.ProjectProperty.GetAll(ctx, projectUUID, po)
+		return client.ProjectProperty.GetAll(ctx, projectUUID, po)
 	})
 	if err != nil {
 		return dtrack.ProjectProperty{}, false, err
@@ -3,21 +3,40 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
+// projectClassifiers are the CycloneDX component types Dependency-Track
+// accepts as a project classifier.
+var projectClassifiers = []string{
+	"APPLICATION", "FRAMEWORK", "LIBRARY", "CONTAINER", "OPERATING_SYSTEM", "DEVICE",
+	"FIRMWARE", "FILE", "PLATFORM", "DEVICE_DRIVER", "MACHINE_LEARNING_MODEL", "DATA",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ProjectResource{}
 var _ resource.ResourceWithImportState = &ProjectResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectResource{}
 
 func NewProjectResource() resource.Resource {
 	return &ProjectResource{}
@@ -30,19 +49,82 @@ type ProjectResource struct {
 
 // ProjectResourceModel describes the resource data model.
 type ProjectResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Version     types.String `tfsdk:"version"`
-	Description types.String `tfsdk:"description"`
-	Group       types.String `tfsdk:"group"`
-	Publisher   types.String `tfsdk:"publisher"`
-	Author      types.String `tfsdk:"author"`
-	Classifier  types.String `tfsdk:"classifier"`
-	Active      types.Bool   `tfsdk:"active"`
-	CPE         types.String `tfsdk:"cpe"`
-	PURL        types.String `tfsdk:"purl"`
-	SWIDTagID   types.String `tfsdk:"swid_tag_id"`
-	ParentUUID  types.String `tfsdk:"parent_uuid"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Version         types.String `tfsdk:"version"`
+	Description     types.String `tfsdk:"description"`
+	Group           types.String `tfsdk:"group"`
+	Publisher       types.String `tfsdk:"publisher"`
+	Author          types.String `tfsdk:"author"`
+	Classifier      types.String `tfsdk:"classifier"`
+	Active          types.Bool   `tfsdk:"active"`
+	CPE             types.String `tfsdk:"cpe"`
+	PURL            types.String `tfsdk:"purl"`
+	SWIDTagID       types.String `tfsdk:"swid_tag_id"`
+	ParentUUID      types.String `tfsdk:"parent_uuid"`
+	CollectionLogic types.String `tfsdk:"collection_logic"`
+	CollectionTag   types.String `tfsdk:"collection_tag"`
+	IsLatest        types.Bool   `tfsdk:"is_latest"`
+
+	Supplier     types.Object `tfsdk:"supplier"`
+	Manufacturer types.Object `tfsdk:"manufacturer"`
+
+	Authors            types.List `tfsdk:"authors"`
+	ExternalReferences types.List `tfsdk:"external_references"`
+
+	GrantAccessTeams types.Set `tfsdk:"grant_access_teams"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+// ProjectAuthorModel describes an author of a project.
+type ProjectAuthorModel struct {
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+// ProjectExternalReferenceModel describes an external reference of a project.
+type ProjectExternalReferenceModel struct {
+	Type    types.String `tfsdk:"type"`
+	URL     types.String `tfsdk:"url"`
+	Comment types.String `tfsdk:"comment"`
+}
+
+// ProjectOrganizationModel describes the supplier or manufacturer of a project.
+type ProjectOrganizationModel struct {
+	Name     types.String `tfsdk:"name"`
+	URL      types.String `tfsdk:"url"`
+	Contacts types.List   `tfsdk:"contacts"`
+}
+
+// ProjectContactModel describes a contact of a ProjectOrganizationModel.
+type ProjectContactModel struct {
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+	Phone types.String `tfsdk:"phone"`
+}
+
+var projectContactAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"email": types.StringType,
+	"phone": types.StringType,
+}
+
+var projectOrganizationAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"url":      types.StringType,
+	"contacts": types.ListType{ElemType: types.ObjectType{AttrTypes: projectContactAttrTypes}},
+}
+
+var projectAuthorAttrTypes = map[string]attr.Type{
+	"name":  types.StringType,
+	"email": types.StringType,
+}
+
+var projectExternalReferenceAttrTypes = map[string]attr.Type{
+	"type":    types.StringType,
+	"url":     types.StringType,
+	"comment": types.StringType,
 }
 
 func (r *ProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -86,30 +168,40 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				MarkdownDescription: "The publisher of the project",
 			},
 			"author": schema.StringAttribute{
-				Optional:            true,
-				Computed:            true,
-				MarkdownDescription: "The author of the project. On Dependency-Track v5 this deprecated field is accepted on write but never returned on read (v5 tracks authors as a list internally), so the provider preserves the configured value in state on v5.",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The author of the project. On Dependency-Track v5 this deprecated field is accepted on write but never returned on read (v5 tracks authors as a list internally), so the provider preserves the configured value in state on v5. " +
+					"Prefer `authors`, which conflicts with this attribute.",
 			},
 			"classifier": schema.StringAttribute{
-				Optional:            true,
-				Computed:            true,
-				MarkdownDescription: "The classifier of the project (APPLICATION, FRAMEWORK, LIBRARY, CONTAINER, OPERATING_SYSTEM, DEVICE, FIRMWARE, FILE, PLATFORM, DEVICE_DRIVER, MACHINE_LEARNING_MODEL, DATA)",
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The classifier of the project (APPLICATION, FRAMEWORK, LIBRARY, CONTAINER, OPERATING_SYSTEM, DEVICE, FIRMWARE, FILE, PLATFORM, DEVICE_DRIVER, MACHINE_LEARNING_MODEL, DATA). " +
+					"Defaults to the provider's `default_project_classifier` when that is set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectClassifiers...),
+				},
 			},
 			"active": schema.BoolAttribute{
 				Optional:            true,
 				Computed:            true,
-				Default:             booldefault.StaticBool(true),
-				MarkdownDescription: "Whether the project is active",
+				MarkdownDescription: "Whether the project is active. Defaults to the provider's `default_project_active`, which defaults to `true`.",
 			},
 			"cpe": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The Common Platform Enumeration (CPE) of the project",
+				MarkdownDescription: "The Common Platform Enumeration (CPE) of the project, as a CPE 2.3 formatted string or a CPE 2.2 URI",
+				Validators: []validator.String{
+					cpeValidator{},
+				},
 			},
 			"purl": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				MarkdownDescription: "The Package URL (PURL) of the project",
+				MarkdownDescription: "The Package URL (PURL) of the project, e.g. `pkg:maven/org.example/app@1.0.0`",
+				Validators: []validator.String{
+					purlValidator{},
+				},
 			},
 			"swid_tag_id": schema.StringAttribute{
 				Optional:            true,
@@ -120,6 +212,191 @@ func (r *ProjectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				MarkdownDescription: "The UUID of the parent project",
 			},
+			"collection_logic": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "How a collection project aggregates the metrics of its children " +
+					"(NONE, AGGREGATE_DIRECT_CHILDREN, AGGREGATE_DIRECT_CHILDREN_WITH_TAG, AGGREGATE_LATEST_VERSION_CHILDREN). " +
+					"Requires Dependency-Track 4.13 or newer.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(dtrack.CollectionLogicNone),
+						string(dtrack.CollectionLogicAggregateDirectChildren),
+						string(dtrack.CollectionLogicAggregateDirectChildrenWithTag),
+						string(dtrack.CollectionLogicAggregateLatestVersionChildren),
+					),
+				},
+			},
+			"collection_tag": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The tag children must carry to be aggregated when `collection_logic` is AGGREGATE_DIRECT_CHILDREN_WITH_TAG. " +
+					"Dependency-Track stores tag names in lowercase, so use a lowercase value. " +
+					"Requires Dependency-Track 4.13 or newer.",
+			},
+			"is_latest": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Whether this is the latest version of the project. " +
+					"Only one version of a project name can be the latest, so setting this to `true` clears the flag on the previously latest version. " +
+					"Set it on a single version only: leave it unset on the others, where it then just reports the current value. " +
+					"Requires Dependency-Track 4.12 or newer.",
+			},
+			"supplier":     projectOrganizationAttribute("The organization supplying the project, reported as the supplier in the project's CycloneDX metadata"),
+			"manufacturer": projectOrganizationAttribute("The organization manufacturing the project, reported as the manufacturer in the project's CycloneDX metadata"),
+			"authors": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The authors of the project, as listed in its CycloneDX metadata. Conflicts with `author`. " +
+					"When unset, authors set outside of Terraform are left alone and not tracked. " +
+					"Requires Dependency-Track 4.13 or newer.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("author")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The name of the author",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"email": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The email address of the author",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"external_references": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "External references of the project, such as its website or version control repository. " +
+					"When unset, references set outside of Terraform (e.g. from an uploaded BOM) are left alone and not tracked.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The CycloneDX type of the reference, e.g. `website`, `vcs`, `issue-tracker` or `documentation`",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"url": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The URL of the reference",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"comment": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "A comment describing the reference",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"grant_access_teams": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "UUIDs of teams to grant access to the project through ACL mappings, added right after the project is created. " +
+					"Teams removed from the set lose access again, and a mapping removed outside of Terraform shows up as drift. " +
+					"A convenience over separate `dependencytrack_acl_mapping` resources; do not manage the same team and project with both.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(uuidValidator{}),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying the project (including replacing it) fails, since deleting a project also deletes its findings, audit trail and metrics history. " +
+					"Set it to `false` and apply before destroying the project. This is a Terraform-only setting that is not stored in Dependency-Track. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+// projectOrganizationAttribute returns the schema of the supplier or
+// manufacturer attribute.
+func projectOrganizationAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		MarkdownDescription: description + ". When unset, one set outside of Terraform is left alone and not tracked. " +
+			"It is not imported.",
+		Validators: []validator.Object{
+			objectvalidator.AtLeastOneOf(
+				path.MatchRelative().AtName("name"),
+				path.MatchRelative().AtName("url"),
+				path.MatchRelative().AtName("contacts"),
+			),
+		},
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The name of the organization",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The website of the organization. Dependency-Track stores a list of URLs; " +
+					"this one is kept first, and any others, e.g. set outside of Terraform, are kept after it and not tracked. " +
+					"When unset, the organization's URLs are left alone and not tracked.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"contacts": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "The contacts of the organization",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Validators: []validator.Object{
+						objectvalidator.AtLeastOneOf(
+							path.MatchRelative().AtName("name"),
+							path.MatchRelative().AtName("email"),
+							path.MatchRelative().AtName("phone"),
+						),
+					},
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The name of the contact",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"email": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The email address of the contact",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"phone": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "The phone number of the contact",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -163,7 +440,10 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		CPE:         data.CPE.ValueString(),
 		PURL:        data.PURL.ValueString(),
 		SWIDTagID:   data.SWIDTagID.ValueString(),
+		Tags:        defaultProjectTags(r.data.DefaultTags),
 	}
+	applyProjectCollection(data, &project)
+	applyProjectIsLatest(data, &project)
 
 	if !data.ParentUUID.IsNull() && !data.ParentUUID.IsUnknown() {
 		parentUUID, err := uuid.Parse(data.ParentUUID.ValueString())
@@ -174,7 +454,28 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		project.ParentRef = &dtrack.ParentRef{UUID: parentUUID}
 	}
 
-	createdProject, err := r.data.Client.Project.Create(ctx, project)
+	previousLatest, err := r.previousLatestVersion(ctx, project)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up the latest project version, got error: %s", err))
+		return
+	}
+
+	externalReferences, diags := projectExternalReferencesFromModel(ctx, data.ExternalReferences)
+	resp.Diagnostics.Append(diags...)
+	authors, diags := projectAuthorsFromModel(ctx, data.Authors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	project.ExternalReferences = externalReferences
+	request, diags := newProjectWithMetadata(ctx, data, project, authors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createdProject projectWithMetadata
+	err = r.data.API().Do(ctx, http.MethodPut, "/api/v1/project", request, &createdProject)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s", err))
 		return
@@ -196,8 +497,32 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 	if createdProject.ParentRef != nil {
 		data.ParentUUID = types.StringValue(createdProject.ParentRef.UUID.String())
 	}
+	setProjectCollectionState(&data, createdProject.Project)
+	resp.Diagnostics.Append(setProjectOrganizationsState(ctx, &data, createdProject)...)
+	resp.Diagnostics.Append(setProjectListsState(ctx, &data, createdProject)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IsLatest = types.BoolValue(projectIsLatest(createdProject.Project))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teams []string
+	resp.Diagnostics.Append(data.GrantAccessTeams.ElementsAs(ctx, &teams, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.grantAccess(ctx, createdProject.UUID, teams); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant teams access to the project, got error: %s", err))
+		return
+	}
+
+	if err := clearPreviousLatest(ctx, r.data, previousLatest, createdProject.Project); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear is_latest on the previous project version, got error: %s", err))
+	}
 }
 
 func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -215,8 +540,15 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	project, err := r.data.Client.Project.Get(ctx, projectUUID)
+	// Get by UUID returns inactive projects as well, unlike the list and
+	// lookup endpoints, so a project with active = false is still found here.
+	var project projectWithMetadata
+	err = r.data.API().Do(ctx, http.MethodGet, "/api/v1/project/"+projectUUID.String(), nil, &project)
 	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
 		return
 	}
@@ -257,14 +589,48 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	} else {
 		data.ParentUUID = types.StringNull()
 	}
+	setProjectCollectionState(&data, project.Project)
+	resp.Diagnostics.Append(setProjectOrganizationsState(ctx, &data, project)...)
+	resp.Diagnostics.Append(setProjectListsState(ctx, &data, project)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IsLatest = types.BoolValue(projectIsLatest(project.Project))
+	// deletion_protection only lives in state; right after import it is
+	// unset and resolves to its default.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
+	if !data.GrantAccessTeams.IsNull() {
+		var teams []string
+		resp.Diagnostics.Append(data.GrantAccessTeams.ElementsAs(ctx, &teams, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		granted, err := r.teamsWithAccess(ctx, project.UUID, teams)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project ACL mappings, got error: %s", err))
+			return
+		}
+
+		grantedSet, diags := types.SetValueFrom(ctx, types.StringType, granted)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.GrantAccessTeams = grantedSet
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ProjectResourceModel
+	var data, state ProjectResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -300,7 +666,63 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 		project.ParentRef = &dtrack.ParentRef{UUID: parentUUID}
 	}
 
-	updatedProject, err := r.data.Client.Project.Update(ctx, project)
+	// The update endpoint replaces the project's tags with the ones in the
+	// request. Tags aren't managed by this resource, so carry over whatever
+	// the project currently has (including provider default_tags applied at
+	// creation) rather than clearing them.
+	var existingProject projectWithMetadata
+	err = r.data.API().Do(ctx, http.MethodGet, "/api/v1/project/"+projectUUID.String(), nil, &existingProject)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+	project.Tags = existingProject.Tags
+	// Likewise keep the current collection settings when they aren't
+	// configured, so an unrelated change doesn't reset them.
+	project.CollectionLogic = existingProject.CollectionLogic
+	project.CollectionTag = existingProject.CollectionTag
+	applyProjectCollection(data, &project)
+	// The update endpoint resets a missing isLatest to false, which would
+	// silently take the flag away from this version.
+	project.IsLatest = existingProject.IsLatest
+	applyProjectIsLatest(data, &project)
+	// Authors and external references are replaced as well; unmanaged ones
+	// are kept. Authors are not carried over alongside a configured author,
+	// which the server may derive them from.
+	externalReferences, diags := projectExternalReferencesFromModel(ctx, data.ExternalReferences)
+	resp.Diagnostics.Append(diags...)
+	authors, diags := projectAuthorsFromModel(ctx, data.Authors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	project.ExternalReferences = externalReferences
+	if data.ExternalReferences.IsNull() {
+		project.ExternalReferences = existingProject.ExternalReferences
+	}
+	if data.Authors.IsNull() && data.Author.ValueString() == "" {
+		authors = existingProject.Authors
+	}
+
+	previousLatest, err := r.previousLatestVersion(ctx, project)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up the latest project version, got error: %s", err))
+		return
+	}
+
+	// The update endpoint clears the supplier and manufacturer when they are
+	// missing from the request, so unmanaged ones are carried over, and so
+	// are URLs of managed ones beyond the configured url.
+	request, diags := newProjectWithMetadata(ctx, data, project, authors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request.Supplier = mergeProjectOrganization(request.Supplier, existingProject.Supplier, projectOrganizationURL(ctx, state.Supplier))
+	request.Manufacturer = mergeProjectOrganization(request.Manufacturer, existingProject.Manufacturer, projectOrganizationURL(ctx, state.Manufacturer))
+
+	var updatedProject projectWithMetadata
+	err = r.data.API().Do(ctx, http.MethodPost, "/api/v1/project", request, &updatedProject)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s", err))
 		return
@@ -322,8 +744,116 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if updatedProject.ParentRef != nil {
 		data.ParentUUID = types.StringValue(updatedProject.ParentRef.UUID.String())
 	}
+	setProjectCollectionState(&data, updatedProject.Project)
+	resp.Diagnostics.Append(setProjectOrganizationsState(ctx, &data, updatedProject)...)
+	resp.Diagnostics.Append(setProjectListsState(ctx, &data, updatedProject)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IsLatest = types.BoolValue(projectIsLatest(updatedProject.Project))
+
+	var teams, priorTeams []string
+	resp.Diagnostics.Append(data.GrantAccessTeams.ElementsAs(ctx, &teams, false)...)
+	resp.Diagnostics.Append(state.GrantAccessTeams.ElementsAs(ctx, &priorTeams, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Compare canonical UUIDs, so that changing only the case of a team UUID
+	// neither grants nor revokes anything.
+	teams, priorTeams = canonicalUUIDs(teams), canonicalUUIDs(priorTeams)
+	if err := r.grantAccess(ctx, updatedProject.UUID, stringSetDifference(teams, priorTeams)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant teams access to the project, got error: %s", err))
+		return
+	}
+	if err := r.revokeAccess(ctx, updatedProject.UUID, stringSetDifference(priorTeams, teams)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke team access to the project, got error: %s", err))
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := clearPreviousLatest(ctx, r.data, previousLatest, updatedProject.Project); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear is_latest on the previous project version, got error: %s", err))
+	}
+}
+
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	// (e.g. during validation with unknown provider configuration).
+	if req.Plan.Raw.IsNull() || r.data == nil {
+		return
+	}
+
+	var config ProjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unset attributes take the provider-level defaults.
+	if config.Active.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active"), r.data.DefaultProjectActive)...)
+	}
+	if config.Classifier.IsNull() && r.data.DefaultProjectClassifier != "" {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("classifier"), r.data.DefaultProjectClassifier)...)
+	}
+
+	// Collection projects were introduced in Dependency-Track 4.13; older
+	// servers drop the fields, which would show up as a perpetual diff.
+	if !config.CollectionLogic.IsNull() {
+		requireServerVersion(r.data, path.Root("collection_logic"), 4, 13, &resp.Diagnostics)
+	}
+	if !config.CollectionTag.IsNull() {
+		requireServerVersion(r.data, path.Root("collection_tag"), 4, 13, &resp.Diagnostics)
+	}
+
+	// Projects gained a list of authors in Dependency-Track 4.13; older
+	// servers only store the single author.
+	if !config.Authors.IsNull() {
+		requireServerVersion(r.data, path.Root("authors"), 4, 13, &resp.Diagnostics)
+	}
+
+	// The latest-version flag arrived in Dependency-Track 4.12.
+	if !config.IsLatest.IsNull() && !requireServerVersion(r.data, path.Root("is_latest"), 4, 12, &resp.Diagnostics) {
+		return
+	}
+
+	if config.IsLatest.ValueBool() && !config.Name.IsUnknown() {
+		r.warnLatestTakeover(ctx, req, config.Name.ValueString(), resp)
+	}
+}
+
+// warnLatestTakeover adds a plan warning when applying is_latest = true moves
+// the flag away from another version of the project. If that version is also
+// configured with is_latest = true, the two resources would keep taking the
+// flag from each other on every apply; the warning makes that visible instead
+// of leaving users to chase a perpetual diff.
+func (r *ProjectResource) warnLatestTakeover(ctx context.Context, req resource.ModifyPlanRequest, name string, resp *resource.ModifyPlanResponse) {
+	var currentID types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &currentID)...)
+	}
+
+	latest, err := latestProjectVersion(ctx, r.data, name)
+	if err != nil {
+		// Only a warning depends on the lookup; the apply re-checks anyway.
+		return
+	}
+	if latest == nil || latest.UUID.String() == currentID.ValueString() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("is_latest"),
+		"Latest Project Version Will Change",
+		fmt.Sprintf("Version %q (%s) of project %q is currently the latest. Applying this plan clears is_latest on that version. "+
+			"If it is managed by another dependencytrack_project resource that also sets is_latest = true, "+
+			"remove the attribute there, or the two versions will take the flag from each other on every apply.",
+			latest.Version, latest.UUID, name),
+	)
 }
 
 func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -335,12 +865,24 @@ func (r *ProjectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Project Deletion Protected",
+			fmt.Sprintf("Project %q (version %q) has deletion_protection enabled. "+
+				"Set deletion_protection = false and apply that change before destroying or replacing the project.",
+				data.Name.ValueString(), data.Version.ValueString()),
+		)
+		return
+	}
+
 	projectUUID, err := uuid.Parse(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
 		return
 	}
 
+	// Deleting the project also drops its ACL mappings, including the ones
+	// added for grant_access_teams.
 	err = r.data.Client.Project.Delete(ctx, projectUUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s", err))
@@ -361,3 +903,412 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectUUID.String())...)
 }
+
+// defaultProjectTags converts the provider's default_tags into project tags.
+// Dependency-Track lowercases tag names, so names differing only in case are
+// collapsed into one tag.
+func defaultProjectTags(names []string) []dtrack.Tag {
+	var tags []dtrack.Tag
+	for _, name := range names {
+		if !slices.ContainsFunc(tags, func(t dtrack.Tag) bool { return strings.EqualFold(t.Name, name) }) {
+			tags = append(tags, dtrack.Tag{Name: name})
+		}
+	}
+	return tags
+}
+
+// applyProjectCollection copies the configured collection settings onto
+// project. Unset or unknown attributes leave project untouched.
+func applyProjectCollection(data ProjectResourceModel, project *dtrack.Project) {
+	if !data.CollectionLogic.IsNull() && !data.CollectionLogic.IsUnknown() {
+		logic := dtrack.CollectionLogic(data.CollectionLogic.ValueString())
+		project.CollectionLogic = &logic
+	}
+	if !data.CollectionTag.IsNull() && !data.CollectionTag.IsUnknown() {
+		project.CollectionTag = &dtrack.Tag{Name: data.CollectionTag.ValueString()}
+	}
+}
+
+// applyProjectIsLatest copies a configured is_latest onto project. An unset or
+// unknown value leaves project untouched.
+func applyProjectIsLatest(data ProjectResourceModel, project *dtrack.Project) {
+	if !data.IsLatest.IsNull() && !data.IsLatest.IsUnknown() {
+		project.IsLatest = data.IsLatest.ValueBoolPointer()
+	}
+}
+
+// projectIsLatest reports whether project is flagged as the latest version.
+// Servers older than 4.12 don't return the flag, so it reads as false there.
+func projectIsLatest(project dtrack.Project) bool {
+	return project.IsLatest != nil && *project.IsLatest
+}
+
+// latestProjectVersion returns the version of the project called name that is
+// currently flagged as the latest, using the dedicated lookup endpoint. It
+// returns nil when no version is flagged or the server predates the flag.
+func latestProjectVersion(ctx context.Context, data *Data, name string) (*dtrack.Project, error) {
+	if !data.ServerVersion.AtLeast(4, 12) {
+		return nil, nil
+	}
+
+	latest, err := data.Client.Project.Latest(ctx, name)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if latest.UUID == uuid.Nil {
+		return nil, nil
+	}
+	return &latest, nil
+}
+
+// previousLatestVersion returns the version that loses the latest flag when
+// project is written, or nil when project doesn't claim the flag.
+func (r *ProjectResource) previousLatestVersion(ctx context.Context, project dtrack.Project) (*dtrack.Project, error) {
+	if project.IsLatest == nil || !*project.IsLatest {
+		return nil, nil
+	}
+
+	latest, err := latestProjectVersion(ctx, r.data, project.Name)
+	if err != nil || latest == nil || latest.UUID == project.UUID {
+		return nil, err
+	}
+	return latest, nil
+}
+
+// clearPreviousLatest makes sure previous no longer carries the latest flag
+// once written has claimed it. Dependency-Track normally clears it itself,
+// but not every release does so reliably, and two versions both flagged as
+// latest break the latest-version lookups other tooling relies on.
+func clearPreviousLatest(ctx context.Context, data *Data, previous *dtrack.Project, written dtrack.Project) error {
+	if previous == nil || !projectIsLatest(written) {
+		return nil
+	}
+
+	current, err := data.Client.Project.Get(ctx, previous.UUID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !projectIsLatest(current) {
+		return nil
+	}
+
+	return data.API().Do(ctx, http.MethodPatch, "/api/v1/project/"+previous.UUID.String(), map[string]bool{"isLatest": false}, nil)
+}
+
+// grantAccess adds an ACL mapping from each of teams to the project.
+func (r *ProjectResource) grantAccess(ctx context.Context, projectUUID uuid.UUID, teams []string) error {
+	for _, team := range teams {
+		teamUUID, err := uuid.Parse(team)
+		if err != nil {
+			return fmt.Errorf("invalid team UUID %q: %w", team, err)
+		}
+		if err := r.data.Client.ACL.AddProjectMapping(withConflictRetry(ctx), dtrack.ACLMappingRequest{Team: teamUUID, Project: projectUUID}); err != nil {
+			return fmt.Errorf("team %s: %w", team, err)
+		}
+	}
+	return nil
+}
+
+// revokeAccess removes the ACL mapping from each of teams to the project.
+// Mappings or teams that no longer exist are skipped.
+func (r *ProjectResource) revokeAccess(ctx context.Context, projectUUID uuid.UUID, teams []string) error {
+	for _, team := range teams {
+		teamUUID, err := uuid.Parse(team)
+		if err != nil {
+			return fmt.Errorf("invalid team UUID %q: %w", team, err)
+		}
+		if err := r.data.Client.ACL.RemoveProjectMapping(withConflictRetry(ctx), teamUUID, projectUUID); err != nil && !isNotFound(err) {
+			return fmt.Errorf("team %s: %w", team, err)
+		}
+	}
+	return nil
+}
+
+// teamsWithAccess returns the teams that still have an ACL mapping to the
+// project. Like dependencytrack_acl_mapping, it has to list each team's
+// projects, since there is no endpoint for a single mapping. Teams that no
+// longer exist are left out, and so are entries that are not UUIDs: those
+// can only be in state from before grant_access_teams was validated, and
+// failing on them would block every refresh.
+func (r *ProjectResource) teamsWithAccess(ctx context.Context, projectUUID uuid.UUID, teams []string) ([]string, error) {
+	var granted []string
+	for _, team := range teams {
+		teamUUID, err := uuid.Parse(team)
+		if err != nil {
+			continue
+		}
+
+		_, found, err := findInPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+			return r.data.Client.ACL.GetAllProjects(ctx, teamUUID, po)
+		}, func(project dtrack.Project) bool {
+			return project.UUID == projectUUID
+		})
+		if err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("team %s: %w", team, err)
+		}
+		if found {
+			granted = append(granted, team)
+		}
+	}
+	return granted, nil
+}
+
+// setProjectCollectionState records the collection settings returned by the
+// server. Servers older than 4.13 don't return them at all, in which case
+// both attributes are null.
+func setProjectCollectionState(data *ProjectResourceModel, project dtrack.Project) {
+	if project.CollectionLogic != nil {
+		data.CollectionLogic = types.StringValue(string(*project.CollectionLogic))
+	} else {
+		data.CollectionLogic = types.StringNull()
+	}
+
+	if project.CollectionTag != nil && project.CollectionTag.Name != "" {
+		data.CollectionTag = types.StringValue(project.CollectionTag.Name)
+	} else {
+		data.CollectionTag = types.StringNull()
+	}
+}
+
+// organizationalEntity is a CycloneDX organizational entity, as Dependency-Track
+// stores the supplier and manufacturer of a project.
+type organizationalEntity struct {
+	Name     string                  `json:"name,omitempty"`
+	URLs     []string                `json:"urls,omitempty"`
+	Contacts []organizationalContact `json:"contacts,omitempty"`
+}
+
+// organizationalContact is a contact of an organizationalEntity.
+type organizationalContact struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+	Phone string `json:"phone,omitempty"`
+}
+
+// projectWithMetadata is a project as sent to and returned by
+// /api/v1/project. dtrack.Project does not carry the supplier, manufacturer
+// and authors, so they are encoded and decoded alongside.
+type projectWithMetadata struct {
+	dtrack.Project
+	Supplier     *organizationalEntity   `json:"supplier,omitempty"`
+	Manufacturer *organizationalEntity   `json:"manufacturer,omitempty"`
+	Authors      []organizationalContact `json:"authors,omitempty"`
+}
+
+// newProjectWithMetadata adds authors and the supplier and manufacturer
+// configured in data to project.
+func newProjectWithMetadata(ctx context.Context, data ProjectResourceModel, project dtrack.Project, authors []organizationalContact) (projectWithMetadata, diag.Diagnostics) {
+	supplier, diags := projectOrganizationFromModel(ctx, data.Supplier)
+	manufacturer, d := projectOrganizationFromModel(ctx, data.Manufacturer)
+	diags.Append(d...)
+	return projectWithMetadata{
+		Project:      project,
+		Authors:      authors,
+		Supplier:     supplier,
+		Manufacturer: manufacturer,
+	}, diags
+}
+
+// projectOrganizationFromModel converts the supplier or manufacturer attribute
+// to the request format, or returns nil when it is not configured.
+func projectOrganizationFromModel(ctx context.Context, object types.Object) (*organizationalEntity, diag.Diagnostics) {
+	if object.IsNull() || object.IsUnknown() {
+		return nil, nil
+	}
+
+	var model ProjectOrganizationModel
+	diags := object.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	organization := &organizationalEntity{Name: model.Name.ValueString()}
+	if !model.URL.IsNull() {
+		organization.URLs = []string{model.URL.ValueString()}
+	}
+
+	var contacts []ProjectContactModel
+	diags.Append(model.Contacts.ElementsAs(ctx, &contacts, false)...)
+	for _, contact := range contacts {
+		organization.Contacts = append(organization.Contacts, organizationalContact{
+			Name:  contact.Name.ValueString(),
+			Email: contact.Email.ValueString(),
+			Phone: contact.Phone.ValueString(),
+		})
+	}
+	return organization, diags
+}
+
+// mergeProjectOrganization returns the supplier or manufacturer to send on
+// update: existing when it is not configured, otherwise configured followed
+// by the existing URLs it does not have. The previously configured url is
+// not carried over, so that changing the url replaces it.
+func mergeProjectOrganization(configured, existing *organizationalEntity, previousURL types.String) *organizationalEntity {
+	if configured == nil {
+		return existing
+	}
+	if existing == nil {
+		return configured
+	}
+
+	for _, url := range existing.URLs {
+		if !slices.Contains(configured.URLs, url) && url != previousURL.ValueString() {
+			configured.URLs = append(configured.URLs, url)
+		}
+	}
+	return configured
+}
+
+// setProjectOrganizationsState copies the supplier and manufacturer of project
+// into data. Like the authors, they are only tracked when configured, and so
+// is the url of each.
+func setProjectOrganizationsState(ctx context.Context, data *ProjectResourceModel, project projectWithMetadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+	if !data.Supplier.IsNull() {
+		data.Supplier, d = projectOrganizationState(ctx, project.Supplier, !projectOrganizationURL(ctx, data.Supplier).IsNull())
+		diags.Append(d...)
+	}
+	if !data.Manufacturer.IsNull() {
+		data.Manufacturer, d = projectOrganizationState(ctx, project.Manufacturer, !projectOrganizationURL(ctx, data.Manufacturer).IsNull())
+		diags.Append(d...)
+	}
+	return diags
+}
+
+// projectOrganizationURL returns the url of the supplier or manufacturer
+// attribute object, which is null when the object is.
+func projectOrganizationURL(ctx context.Context, object types.Object) types.String {
+	var model ProjectOrganizationModel
+	if object.IsNull() || object.IsUnknown() || object.As(ctx, &model, basetypes.ObjectAsOptions{}).HasError() {
+		return types.StringNull()
+	}
+	return model.URL
+}
+
+// projectOrganizationState returns organization as the value of the supplier
+// or manufacturer attribute, with all of its contacts. The url is its first
+// URL when withURL is set, and null otherwise.
+func projectOrganizationState(ctx context.Context, organization *organizationalEntity, withURL bool) (types.Object, diag.Diagnostics) {
+	if organization == nil {
+		return types.ObjectNull(projectOrganizationAttrTypes), nil
+	}
+
+	model := ProjectOrganizationModel{
+		Name:     stringValueOrNull(organization.Name),
+		URL:      types.StringNull(),
+		Contacts: types.ListNull(types.ObjectType{AttrTypes: projectContactAttrTypes}),
+	}
+	if withURL && len(organization.URLs) > 0 {
+		model.URL = stringValueOrNull(organization.URLs[0])
+	}
+
+	var diags diag.Diagnostics
+	if len(organization.Contacts) > 0 {
+		contacts := make([]ProjectContactModel, 0, len(organization.Contacts))
+		for _, contact := range organization.Contacts {
+			contacts = append(contacts, ProjectContactModel{
+				Name:  stringValueOrNull(contact.Name),
+				Email: stringValueOrNull(contact.Email),
+				Phone: stringValueOrNull(contact.Phone),
+			})
+		}
+		var d diag.Diagnostics
+		model.Contacts, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: projectContactAttrTypes}, contacts)
+		diags.Append(d...)
+	}
+
+	object, d := types.ObjectValueFrom(ctx, projectOrganizationAttrTypes, model)
+	diags.Append(d...)
+	return object, diags
+}
+
+// projectAuthorsFromModel converts the authors attribute to the request
+// format, returning nil when it is null.
+func projectAuthorsFromModel(ctx context.Context, list types.List) ([]organizationalContact, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []ProjectAuthorModel
+	diags := list.ElementsAs(ctx, &models, false)
+	authors := make([]organizationalContact, 0, len(models))
+	for _, model := range models {
+		authors = append(authors, organizationalContact{
+			Name:  model.Name.ValueString(),
+			Email: model.Email.ValueString(),
+		})
+	}
+	return authors, diags
+}
+
+// projectExternalReferencesFromModel converts the external_references
+// attribute to the request format, returning nil when it is null.
+func projectExternalReferencesFromModel(ctx context.Context, list types.List) ([]dtrack.ExternalReference, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []ProjectExternalReferenceModel
+	diags := list.ElementsAs(ctx, &models, false)
+	references := make([]dtrack.ExternalReference, 0, len(models))
+	for _, model := range models {
+		references = append(references, dtrack.ExternalReference{
+			Type:    model.Type.ValueString(),
+			URL:     model.URL.ValueString(),
+			Comment: model.Comment.ValueString(),
+		})
+	}
+	return references, diags
+}
+
+// setProjectListsState copies the authors and external references of project
+// into data. Like the tags, they are only tracked when configured, so an
+// unset attribute stays null whatever the project has, and a configured one
+// stays a list even when the project has none, which shows up as drift.
+func setProjectListsState(ctx context.Context, data *ProjectResourceModel, project projectWithMetadata) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.Authors.IsNull() {
+		authors := make([]ProjectAuthorModel, 0, len(project.Authors))
+		for _, author := range project.Authors {
+			authors = append(authors, ProjectAuthorModel{
+				Name:  types.StringValue(author.Name),
+				Email: stringValueOrNull(author.Email),
+			})
+		}
+		list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: projectAuthorAttrTypes}, authors)
+		diags.Append(d...)
+		data.Authors = list
+	}
+
+	if !data.ExternalReferences.IsNull() {
+		references := make([]ProjectExternalReferenceModel, 0, len(project.ExternalReferences))
+		for _, reference := range project.ExternalReferences {
+			references = append(references, ProjectExternalReferenceModel{
+				Type:    types.StringValue(reference.Type),
+				URL:     types.StringValue(reference.URL),
+				Comment: stringValueOrNull(reference.Comment),
+			})
+		}
+		list, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: projectExternalReferenceAttrTypes}, references)
+		diags.Append(d...)
+		data.ExternalReferences = list
+	}
+
+	return diags
+}
+
+// stringValueOrNull returns s as a string value, or null when it is empty.
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -137,3 +144,777 @@ resource "dependencytrack_project" "test" {
 }
 `
 }
+
+// TestAccProjectResource_ToggleActive verifies that deactivating and
+// reactivating a project is an in-place update and that an inactive project
+// can still be read and imported.
+func TestAccProjectResource_ToggleActive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigActive(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("active"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Deactivate in place
+			{
+				Config: testAccProjectResourceConfigActive(false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("active"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// An inactive project refreshes without a diff
+			{
+				Config:   testAccProjectResourceConfigActive(false),
+				PlanOnly: true,
+			},
+			// An inactive project can be imported
+			{
+				ResourceName:      "dependencytrack_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Reactivate in place
+			{
+				Config: testAccProjectResourceConfigActive(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_project.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("active"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigActive(active bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "Test Project Toggle Active"
+  version = "1.0.0"
+  active  = %t
+}
+`, active)
+}
+
+func TestAccProjectResource_IdentifierValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectResourceConfigIdentifiers("cpe:2.3:a:vendor:product", "pkg:maven/org.example/app@1.0.0"),
+				ExpectError: regexp.MustCompile(`Invalid CPE`),
+			},
+			{
+				Config:      testAccProjectResourceConfigIdentifiers("cpe:2.3:a:vendor:product:1.0:*:*:*:*:*:*:*", "maven/org.example/app@1.0.0"),
+				ExpectError: regexp.MustCompile(`Invalid Package URL`),
+			},
+			{
+				Config: testAccProjectResourceConfigIdentifiers("cpe:2.3:a:vendor:product:1.0:*:*:*:*:*:*:*", "pkg:maven/org.example/app@1.0.0"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("purl"),
+						knownvalue.StringExact("pkg:maven/org.example/app@1.0.0"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_GrantAccessTeams(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigGrantAccessTeams(suffix, "dependencytrack_team.first.id"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("grant_access_teams"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+				Check: resource.TestCheckTypeSetElemAttrPair(
+					"data.dependencytrack_acl_mappings.first", "projects.*",
+					"dependencytrack_project.test", "id",
+				),
+			},
+			// Hand access over to the second team; the first one loses it
+			{
+				Config: testAccProjectResourceConfigGrantAccessTeams(suffix, "dependencytrack_team.second.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.dependencytrack_acl_mappings.first", "projects.#", "0"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"data.dependencytrack_acl_mappings.second", "projects.*",
+						"dependencytrack_project.test", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigGrantAccessTeams(suffix, team string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team" "first" {
+  name = "Test Project Access First %[1]s"
+}
+
+resource "dependencytrack_team" "second" {
+  name = "Test Project Access Second %[1]s"
+}
+
+resource "dependencytrack_project" "test" {
+  name               = "Test Project Access %[1]s"
+  grant_access_teams = [%[2]s]
+}
+
+data "dependencytrack_acl_mappings" "first" {
+  team       = dependencytrack_team.first.id
+  depends_on = [dependencytrack_project.test]
+}
+
+data "dependencytrack_acl_mappings" "second" {
+  team       = dependencytrack_team.second.id
+  depends_on = [dependencytrack_project.test]
+}
+`, suffix, team)
+}
+
+func TestAccProjectResource_Organizations(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigOrganizations(suffix, `
+  supplier = {
+    name = "Acme Supply"
+    url  = "https://supply.example.com"
+    contacts = [
+      { name = "Jane Doe", email = "jane@example.com", phone = "+1 555 0100" },
+      { email = "support@example.com" },
+    ]
+  }
+  manufacturer = {
+    name = "Acme Manufacturing"
+  }
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("supplier").AtMapKey("name"), knownvalue.StringExact("Acme Supply")),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("supplier").AtMapKey("url"), knownvalue.StringExact("https://supply.example.com")),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("supplier").AtMapKey("contacts"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":  knownvalue.StringExact("Jane Doe"),
+							"email": knownvalue.StringExact("jane@example.com"),
+							"phone": knownvalue.StringExact("+1 555 0100"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":  knownvalue.Null(),
+							"email": knownvalue.StringExact("support@example.com"),
+							"phone": knownvalue.Null(),
+						}),
+					})),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("manufacturer").AtMapKey("name"), knownvalue.StringExact("Acme Manufacturing")),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("manufacturer").AtMapKey("contacts"), knownvalue.Null()),
+				},
+			},
+			// The supplier and manufacturer are only tracked when configured,
+			// so an import leaves them out.
+			{
+				ResourceName:            "dependencytrack_project.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"supplier", "manufacturer"},
+			},
+			// Updating the project changes the supplier's contacts and drops
+			// its URL, and keeps the manufacturer, which is no longer
+			// configured
+			{
+				Config: testAccProjectResourceConfigOrganizations(suffix, `
+  description = "updated"
+  supplier = {
+    name     = "Acme Supply"
+    contacts = [{ name = "Jane Doe", email = "jane@example.com" }]
+  }
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("supplier").AtMapKey("contacts").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact("Jane Doe")),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("supplier").AtMapKey("url"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("manufacturer"), knownvalue.Null()),
+				},
+				Check: resource.TestCheckResourceAttrWith("dependencytrack_project.test", "id", func(id string) error {
+					var project projectWithMetadata
+					if status := testAccAPIDo(t, http.MethodGet, "/api/v1/project/"+id, nil, &project); status != http.StatusOK {
+						return fmt.Errorf("reading project: unexpected status %d", status)
+					}
+					if project.Manufacturer == nil || project.Manufacturer.Name != "Acme Manufacturing" {
+						return fmt.Errorf("manufacturer = %+v, want it kept", project.Manufacturer)
+					}
+					if project.Supplier == nil || len(project.Supplier.URLs) != 0 {
+						return fmt.Errorf("supplier = %+v, want it without URLs", project.Supplier)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigOrganizations(suffix, attributes string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name = "Test Project Organizations %s"
+%s}
+`, suffix, attributes)
+}
+
+func TestAccProjectResource_AuthorsAndExternalReferences(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigOrganizations(suffix, `
+  authors = [
+    { name = "Jane Doe", email = "jane@example.com" },
+    { name = "John Doe" },
+  ]
+  external_references = [
+    { type = "vcs", url = "https://git.example.com/app.git" },
+    { type = "website", url = "https://app.example.com", comment = "Landing page" },
+  ]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("authors"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":  knownvalue.StringExact("Jane Doe"),
+							"email": knownvalue.StringExact("jane@example.com"),
+						}),
+						knownvalue.ObjectExact(map[string]knownvalue.Check{
+							"name":  knownvalue.StringExact("John Doe"),
+							"email": knownvalue.Null(),
+						}),
+					})),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("external_references"), knownvalue.ListSizeExact(2)),
+				},
+			},
+			// An unrelated change keeps the lists as configured
+			{
+				Config: testAccProjectResourceConfigOrganizations(suffix, `
+  description = "updated"
+  authors = [
+    { name = "Jane Doe", email = "jane@example.com" },
+  ]
+  external_references = [
+    { type = "vcs", url = "https://git.example.com/app.git" },
+  ]
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("authors"), knownvalue.ListSizeExact(1)),
+					statecheck.ExpectKnownValue("dependencytrack_project.test", tfjsonpath.New("external_references"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_AuthorConflictsWithAuthors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigOrganizations("conflict", `
+  author  = "Jane Doe"
+  authors = [{ name = "Jane Doe" }]
+`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_InvalidClassifier(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_project" "test" {
+  name       = "Test Project Invalid Classifier"
+  classifier = "APLICATION"
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigIdentifiers(cpe, purl string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "Test Project Identifiers"
+  version = "1.0.0"
+  cpe     = %q
+  purl    = %q
+}
+`, cpe, purl)
+}
+
+func TestAccProjectResource_ProviderDefaultTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The default tag is applied on create.
+			{
+				Config: testAccProjectResourceConfigDefaultTags("Initial description"),
+				Check: resource.TestCheckTypeSetElemNestedAttrs(
+					"data.dependencytrack_tags.test",
+					"tags.*",
+					map[string]string{
+						"name":          "tf-acc-default-tag",
+						"project_count": "1",
+					},
+				),
+			},
+			// Updating the project keeps the default tag.
+			{
+				Config: testAccProjectResourceConfigDefaultTags("Updated description"),
+				Check: resource.TestCheckTypeSetElemNestedAttrs(
+					"data.dependencytrack_tags.test",
+					"tags.*",
+					map[string]string{
+						"name":          "tf-acc-default-tag",
+						"project_count": "1",
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigDefaultTags(description string) string {
+	return fmt.Sprintf(`
+provider "dependencytrack" {
+  endpoint     = %q
+  api_key      = %q
+  default_tags = ["tf-acc-default-tag"]
+}
+
+resource "dependencytrack_project" "test" {
+  name        = "Test Project Default Tags"
+  description = %q
+}
+
+data "dependencytrack_tags" "test" {
+  depends_on = [dependencytrack_project.test]
+}
+`, os.Getenv("DEPENDENCYTRACK_ENDPOINT"), os.Getenv("DEPENDENCYTRACK_API_KEY"), description)
+}
+
+func TestAccProjectResource_ProviderDefaults(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigProviderDefaults(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_project.defaulted", tfjsonpath.New("active"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("dependencytrack_project.defaulted", tfjsonpath.New("classifier"), knownvalue.StringExact("LIBRARY")),
+					statecheck.ExpectKnownValue("dependencytrack_project.explicit", tfjsonpath.New("active"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("dependencytrack_project.explicit", tfjsonpath.New("classifier"), knownvalue.StringExact("APPLICATION")),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigProviderDefaults(suffix string) string {
+	return fmt.Sprintf(`
+provider "dependencytrack" {
+  endpoint                   = %q
+  api_key                    = %q
+  default_project_active     = false
+  default_project_classifier = "LIBRARY"
+}
+
+resource "dependencytrack_project" "defaulted" {
+  name = "Test Project Provider Defaults %[3]s"
+}
+
+resource "dependencytrack_project" "explicit" {
+  name       = "Test Project Provider Defaults Explicit %[3]s"
+  active     = true
+  classifier = "APPLICATION"
+}
+`, os.Getenv("DEPENDENCYTRACK_ENDPOINT"), os.Getenv("DEPENDENCYTRACK_API_KEY"), suffix)
+}
+
+func TestDefaultProjectTags(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []string
+		want  []string
+	}{
+		{name: "nil", input: nil, want: nil},
+		{name: "distinct", input: []string{"a", "b"}, want: []string{"a", "b"}},
+		{name: "case duplicates collapsed", input: []string{"team-a", "Team-A", "b"}, want: []string{"team-a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := defaultProjectTags(tt.input)
+			if len(got) != len(tt.want) {
+				t.Fatalf("defaultProjectTags(%v) returned %d tags, want %d", tt.input, len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].Name != tt.want[i] {
+					t.Errorf("tag %d = %q, want %q", i, got[i].Name, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestProjectOrganizationRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		organization *organizationalEntity
+	}{
+		{name: "nil"},
+		{name: "name only", organization: &organizationalEntity{Name: "Acme"}},
+		{name: "url and contacts", organization: &organizationalEntity{
+			Name: "Acme",
+			URLs: []string{"https://acme.example.com"},
+			Contacts: []organizationalContact{
+				{Name: "Jane Doe", Email: "jane@example.com", Phone: "+1 555 0100"},
+				{Email: "support@example.com"},
+			},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			object, diags := projectOrganizationState(ctx, tt.organization, true)
+			if diags.HasError() {
+				t.Fatalf("projectOrganizationState() diagnostics: %v", diags)
+			}
+			got, diags := projectOrganizationFromModel(ctx, object)
+			if diags.HasError() {
+				t.Fatalf("projectOrganizationFromModel() diagnostics: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.organization) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.organization)
+			}
+		})
+	}
+}
+
+func TestMergeProjectOrganization(t *testing.T) {
+	existing := &organizationalEntity{Name: "Acme", URLs: []string{"https://old.example.com", "https://other.example.com"}}
+	tests := []struct {
+		name        string
+		configured  *organizationalEntity
+		existing    *organizationalEntity
+		previousURL types.String
+		want        *organizationalEntity
+	}{
+		{name: "not configured", existing: existing, previousURL: types.StringNull(), want: existing},
+		{name: "nothing existing", configured: &organizationalEntity{Name: "New"}, previousURL: types.StringNull(), want: &organizationalEntity{Name: "New"}},
+		{
+			name:        "url unset",
+			configured:  &organizationalEntity{Name: "New"},
+			existing:    existing,
+			previousURL: types.StringNull(),
+			want:        &organizationalEntity{Name: "New", URLs: []string{"https://old.example.com", "https://other.example.com"}},
+		},
+		{
+			name:        "url changed",
+			configured:  &organizationalEntity{Name: "New", URLs: []string{"https://new.example.com"}},
+			existing:    existing,
+			previousURL: types.StringValue("https://old.example.com"),
+			want:        &organizationalEntity{Name: "New", URLs: []string{"https://new.example.com", "https://other.example.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var configured *organizationalEntity
+			if tt.configured != nil {
+				copied := *tt.configured
+				configured = &copied
+			}
+			got := mergeProjectOrganization(configured, tt.existing, tt.previousURL)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeProjectOrganization() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccProjectResource_CollectionLogic(t *testing.T) {
+	if !testAccServerVersion(t).AtLeast(4, 13) {
+		t.Skip("collection projects require Dependency-Track 4.13 or newer")
+	}
+
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create as a collection aggregating tagged children
+			{
+				Config: testAccProjectResourceConfigCollection(suffix, `
+  collection_logic = "AGGREGATE_DIRECT_CHILDREN_WITH_TAG"
+  collection_tag   = "prod"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("collection_logic"),
+						knownvalue.StringExact("AGGREGATE_DIRECT_CHILDREN_WITH_TAG"),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("collection_tag"),
+						knownvalue.StringExact("prod"),
+					),
+				},
+			},
+			// An unrelated change keeps the collection settings
+			{
+				Config: testAccProjectResourceConfigCollection(suffix, `
+  description      = "updated"
+  collection_logic = "AGGREGATE_DIRECT_CHILDREN_WITH_TAG"
+  collection_tag   = "prod"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("collection_tag"),
+						knownvalue.StringExact("prod"),
+					),
+				},
+			},
+			// Switch the aggregation mode
+			{
+				Config: testAccProjectResourceConfigCollection(suffix, `
+  collection_logic = "AGGREGATE_DIRECT_CHILDREN"
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("collection_logic"),
+						knownvalue.StringExact("AGGREGATE_DIRECT_CHILDREN"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_CollectionLogicUnsupported(t *testing.T) {
+	if testAccServerVersion(t).AtLeast(4, 13) {
+		t.Skip("only applies to Dependency-Track versions older than 4.13")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigCollection(randomSuffix(), `
+  collection_logic = "AGGREGATE_DIRECT_CHILDREN"
+`),
+				ExpectError: regexp.MustCompile(`requires Dependency-Track 4\.13 or newer`),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigCollection(suffix, attributes string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "Test Collection Project %s"
+  version = "1.0.0"
+%s}
+`, suffix, attributes)
+}
+
+// TestAccProjectResource_IsLatest moves the latest flag from one version to
+// another and verifies the previous version loses it without a diff on the
+// resource that manages it.
+func TestAccProjectResource_IsLatest(t *testing.T) {
+	if !testAccServerVersion(t).AtLeast(4, 12) {
+		t.Skip("the latest version flag requires Dependency-Track 4.12 or newer")
+	}
+
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigIsLatest(suffix, `is_latest = true`, ``),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.v1",
+						tfjsonpath.New("is_latest"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.v2",
+						tfjsonpath.New("is_latest"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Hand the flag to v2; v1 only reports the flag from now on
+			{
+				Config: testAccProjectResourceConfigIsLatest(suffix, ``, `is_latest = true`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.v2",
+						tfjsonpath.New("is_latest"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// After a refresh v1 reports that it lost the flag, with no diff
+			{
+				Config: testAccProjectResourceConfigIsLatest(suffix, ``, `is_latest = true`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.v1",
+						tfjsonpath.New("is_latest"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.v2",
+						tfjsonpath.New("is_latest"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProjectResource_IsLatestUnsupported(t *testing.T) {
+	if testAccServerVersion(t).AtLeast(4, 12) {
+		t.Skip("only applies to Dependency-Track versions older than 4.12")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectResourceConfigIsLatest(randomSuffix(), `is_latest = true`, ``),
+				ExpectError: regexp.MustCompile(`requires Dependency-Track 4\.12 or newer`),
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigIsLatest(suffix, v1Attributes, v2Attributes string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "v1" {
+  name    = "Test Latest Project %[1]s"
+  version = "1.0.0"
+  %[2]s
+}
+
+resource "dependencytrack_project" "v2" {
+  name    = "Test Latest Project %[1]s"
+  version = "2.0.0"
+  %[3]s
+
+  depends_on = [dependencytrack_project.v1]
+}
+`, suffix, v1Attributes, v2Attributes)
+}
+
+func TestAccProjectResource_DeletionProtection(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceConfigDeletionProtection(suffix, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("deletion_protection"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Destroying a protected project fails
+			{
+				Config:      testAccProjectResourceConfigDeletionProtection(suffix, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`deletion_protection enabled`),
+			},
+			// Lifting the protection lets the test clean up
+			{
+				Config: testAccProjectResourceConfigDeletionProtection(suffix, false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project.test",
+						tfjsonpath.New("deletion_protection"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectResourceConfigDeletionProtection(suffix string, protected bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name                = "Test Protected Project %s"
+  version             = "1.0.0"
+  deletion_protection = %t
+}
+`, suffix, protected)
+}
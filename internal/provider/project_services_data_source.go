@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProjectServicesDataSource{}
+
+func NewProjectServicesDataSource() datasource.DataSource {
+	return &ProjectServicesDataSource{}
+}
+
+// ProjectServicesDataSource defines the data source implementation.
+type ProjectServicesDataSource struct {
+	data *Data
+}
+
+// ProjectServicesDataSourceModel describes the data source data model.
+type ProjectServicesDataSourceModel struct {
+	ID       types.String          `tfsdk:"id"`
+	Project  types.String          `tfsdk:"project"`
+	Services []ProjectServiceModel `tfsdk:"services"`
+}
+
+// ProjectServiceModel describes an individual service.
+type ProjectServiceModel struct {
+	UUID      types.String `tfsdk:"uuid"`
+	Name      types.String `tfsdk:"name"`
+	Version   types.String `tfsdk:"version"`
+	Group     types.String `tfsdk:"group"`
+	Provider  types.String `tfsdk:"provider"`
+	Endpoints types.List   `tfsdk:"endpoints"`
+}
+
+// ServiceComponent represents the API model of a CycloneDX service. The
+// client library does not cover services, so only the fields the data source
+// exposes are decoded.
+type ServiceComponent struct {
+	UUID     uuid.UUID `json:"uuid"`
+	Name     string    `json:"name"`
+	Version  string    `json:"version,omitempty"`
+	Group    string    `json:"group,omitempty"`
+	Provider *struct {
+		Name string `json:"name,omitempty"`
+	} `json:"provider,omitempty"`
+	Endpoints []string `json:"endpoints,omitempty"`
+}
+
+func (d *ProjectServicesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_services"
+}
+
+func (d *ProjectServicesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the services of a project from Dependency-Track. " +
+			"Services are the external APIs and endpoints a project declares in the `services` section of its CycloneDX BOM.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this data source result (the project UUID)",
+			},
+			"project": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the project",
+			},
+			"services": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of services",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uuid": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the service",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the service",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the service",
+						},
+						"group": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The group (namespace) of the service",
+						},
+						"provider": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the organization that provides the service, if any",
+						},
+						"endpoints": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							MarkdownDescription: "The endpoint URIs of the service",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProjectServicesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *ProjectServicesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ProjectServicesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	services, err := apiGetAllPages[ServiceComponent](ctx, d.data.API(), "/api/v1/service/project/"+projectUUID.String(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project services, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(projectUUID.String())
+	data.Services = make([]ProjectServiceModel, 0, len(services))
+	for i := range services {
+		s := &services[i]
+
+		provider := types.StringNull()
+		if s.Provider != nil && s.Provider.Name != "" {
+			provider = types.StringValue(s.Provider.Name)
+		}
+
+		endpoints, diags := types.ListValueFrom(ctx, types.StringType, append([]string{}, s.Endpoints...))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Services = append(data.Services, ProjectServiceModel{
+			UUID:      types.StringValue(s.UUID.String()),
+			Name:      types.StringValue(s.Name),
+			Version:   types.StringValue(s.Version),
+			Group:     types.StringValue(s.Group),
+			Provider:  provider,
+			Endpoints: endpoints,
+		})
+	}
+
+	tflog.Trace(ctx, "read a project services data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectServicesDataSource(t *testing.T) {
+	projectUUID := testAccSeedProjectWithService(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServicesDataSourceConfig(projectUUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_services.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(projectUUID),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_services.test",
+						tfjsonpath.New("services"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"uuid":     knownvalue.NotNull(),
+								"name":     knownvalue.StringExact("tf-acc-service"),
+								"version":  knownvalue.StringExact("1.2.0"),
+								"group":    knownvalue.StringExact("org.example"),
+								"provider": knownvalue.StringExact("Example Corp"),
+								"endpoints": knownvalue.ListExact([]knownvalue.Check{
+									knownvalue.StringExact("https://api.example.com/v1"),
+									knownvalue.StringExact("https://api.example.com/v2"),
+								}),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+// TestAccProjectServicesDataSource_Empty verifies the data source returns an
+// empty list for a project without services.
+func TestAccProjectServicesDataSource_Empty(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServicesDataSourceEmptyConfig(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_services.test",
+						tfjsonpath.New("services"),
+						knownvalue.ListSizeExact(0),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccProjectServicesDataSourceConfig(projectUUID string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+data "dependencytrack_project_services" "test" {
+  project = %q
+}
+`, projectUUID)
+}
+
+func testAccProjectServicesDataSourceEmptyConfig(suffix string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "tf-acc-no-services-%s"
+  version = "1.0.0"
+}
+
+data "dependencytrack_project_services" "test" {
+  project = dependencytrack_project.test.id
+}
+`, suffix)
+}
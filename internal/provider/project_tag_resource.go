@@ -0,0 +1,234 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectTagResource{}
+var _ resource.ResourceWithImportState = &ProjectTagResource{}
+
+func NewProjectTagResource() resource.Resource {
+	return &ProjectTagResource{}
+}
+
+// ProjectTagResource defines the resource implementation.
+type ProjectTagResource struct {
+	data *Data
+}
+
+// ProjectTagResourceModel describes the resource data model.
+type ProjectTagResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Tag     types.String `tfsdk:"tag"`
+	Project types.String `tfsdk:"project"`
+}
+
+func (r *ProjectTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_tag"
+}
+
+func (r *ProjectTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the assignment of a single tag to a project in Dependency-Track. The tag must already exist (see the `dependencytrack_tag` resource). " +
+			"Each assignment is added and removed on its own, so several modules can tag the same project without overwriting each other's tags. " +
+			"`dependencytrack_project` does not manage tags and keeps whatever tags the project has, so the two can be combined freely. " +
+			"A tag listed in the provider's `default_tags` is already applied to every new project; managing it here as well means destroying the assignment removes the default tag from the project.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the project tag assignment in the format `tag_name/project_uuid`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the tag. Dependency-Track normalizes tag names to lowercase, so a mixed-case name is matched case-insensitively (using a lowercase name is recommended). Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the project",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ProjectTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *ProjectTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	// Dependency-Track stores tag names lowercased; normalize before using the
+	// name in the request path so lookups resolve consistently.
+	tag := strings.ToLower(data.Tag.ValueString())
+
+	err = r.data.Client.Tag.TagProjects(ctx, tag, []uuid.UUID{projectUUID})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to tag project, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Tag.ValueString(), projectUUID.String()))
+
+	tflog.Trace(ctx, "created a project tag resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	// Read the association back from the project itself: a single request that
+	// returns the project's full tag list, and a deleted project surfaces as a
+	// 404 instead of an empty tagged-projects page.
+	project, err := r.data.Client.Project.Get(ctx, projectUUID)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project, got error: %s", err))
+		return
+	}
+
+	if !projectHasTag(project, data.Tag.ValueString()) {
+		// Tag is not assigned to the project anymore, remove from state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Tag.ValueString(), projectUUID.String()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Since both tag and project have RequiresReplace, this should never be called
+	resp.Diagnostics.AddError(
+		"Unexpected Update Call",
+		"Project tag assignments cannot be updated. Both tag and project changes require replacement.",
+	)
+}
+
+func (r *ProjectTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	tag := strings.ToLower(data.Tag.ValueString())
+
+	err = r.data.Client.Tag.UntagProjects(ctx, tag, []uuid.UUID{projectUUID})
+	if err != nil {
+		// The tag or project being gone means there is nothing left to delete.
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to untag project, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a project tag resource")
+}
+
+func (r *ProjectTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using format: tag_name/project_uuid
+	tag, projectID, err := parseCompositeID(req.ID, "tag_name", "project_uuid")
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Project UUID",
+			fmt.Sprintf("Unable to parse project UUID from import ID: %s\nError: %s", projectID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), tag)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), projectUUID.String())...)
+}
+
+// projectHasTag reports whether the project carries the named tag. Dependency-Track
+// lowercases tag names on write, so the comparison is case-insensitive.
+func projectHasTag(project dtrack.Project, tag string) bool {
+	for _, t := range project.Tags {
+		if strings.EqualFold(t.Name, tag) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccProjectTagResource(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProjectTagResourceConfig(suffix),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_tag.first",
+						tfjsonpath.New("tag"),
+						knownvalue.StringExact(fmt.Sprintf("tf-acc-project-tag-a-%s", suffix)),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_tag.second",
+						tfjsonpath.New("tag"),
+						knownvalue.StringExact(fmt.Sprintf("tf-acc-project-tag-b-%s", suffix)),
+					),
+				},
+			},
+			// Both assignments survive each other's creation, so nothing is
+			// planned on a second run
+			{
+				Config:   testAccProjectTagResourceConfig(suffix),
+				PlanOnly: true,
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dependencytrack_project_tag.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccProjectTagResourceConfig(suffix string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_tag" "first" {
+  name = "tf-acc-project-tag-a-%[1]s"
+}
+
+resource "dependencytrack_tag" "second" {
+  name = "tf-acc-project-tag-b-%[1]s"
+}
+
+resource "dependencytrack_project" "test" {
+  name    = "tf-acc-tagged-project-%[1]s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project_tag" "first" {
+  tag     = dependencytrack_tag.first.name
+  project = dependencytrack_project.test.id
+}
+
+resource "dependencytrack_project_tag" "second" {
+  tag     = dependencytrack_tag.second.name
+  project = dependencytrack_project.test.id
+}
+`, suffix)
+}
+
+func TestProjectHasTag(t *testing.T) {
+	project := dtrack.Project{Tags: []dtrack.Tag{{Name: "prod"}, {Name: "team-a"}}}
+
+	tests := []struct {
+		name    string
+		project dtrack.Project
+		tag     string
+		want    bool
+	}{
+		{name: "present", project: project, tag: "prod", want: true},
+		{name: "mixed case", project: project, tag: "Team-A", want: true},
+		{name: "absent", project: project, tag: "staging", want: false},
+		{name: "no tags", project: dtrack.Project{}, tag: "prod", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := projectHasTag(tt.project, tt.tag); got != tt.want {
+				t.Errorf("projectHasTag(%q) = %v, want %v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,404 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ProjectVersionResource{}
+var _ resource.ResourceWithImportState = &ProjectVersionResource{}
+var _ resource.ResourceWithModifyPlan = &ProjectVersionResource{}
+
+func NewProjectVersionResource() resource.Resource {
+	return &ProjectVersionResource{}
+}
+
+// ProjectVersionResource defines the resource implementation.
+type ProjectVersionResource struct {
+	data *Data
+}
+
+// ProjectVersionResourceModel describes the resource data model.
+type ProjectVersionResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Version             types.String `tfsdk:"version"`
+	ParentUUID          types.String `tfsdk:"parent_uuid"`
+	PreviousVersionUUID types.String `tfsdk:"previous_version_uuid"`
+	IsLatest            types.Bool   `tfsdk:"is_latest"`
+	Active              types.Bool   `tfsdk:"active"`
+
+	DeactivatePrevious types.Bool `tfsdk:"deactivate_previous"`
+	DeleteOnDestroy    types.Bool `tfsdk:"delete_on_destroy"`
+}
+
+func (r *ProjectVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_version"
+}
+
+func (r *ProjectVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the current release of a project that is tracked as one Dependency-Track project per version. " +
+			"Creating the resource creates that version as the latest one, superseding the version that was flagged as latest before: " +
+			"the flag moves to the new version, the new version inherits the parent of the superseded one, and the superseded version can optionally be deactivated. " +
+			"Changing `version` replaces the resource, so bumping it in the configuration releases a new version. " +
+			"Superseded versions are kept in Dependency-Track unless `delete_on_destroy` is set. " +
+			"Use `dependencytrack_project` instead for projects that are not released this way. " +
+			"Requires Dependency-Track 4.12 or newer.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the project version",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the project. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The version of the project. Changing this creates the new version and supersedes the current one.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_uuid": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The UUID of the parent project. " +
+					"Defaults to the parent of the version being superseded, so versions stay grouped under the same parent.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_version_uuid": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID of the version that was the latest before this one was created, or null if there was none.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_latest": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether this version is still flagged as the latest version of the project.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether this version is active.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deactivate_previous": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, the superseded version is set inactive when this version is created. " +
+					"This is a Terraform-only setting that is not stored in Dependency-Track. Defaults to `false`.",
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying the resource (including replacing it when `version` changes) deletes the project version, " +
+					"along with its findings, audit trail and metrics history. " +
+					"Otherwise destroying it only removes it from the Terraform state and the version stays in Dependency-Track. " +
+					"Combine it with `create_before_destroy` so the new version still supersedes the old one before it is deleted. " +
+					"This is a Terraform-only setting that is not stored in Dependency-Track. Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (r *ProjectVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *ProjectVersionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or before the provider is configured
+	// (e.g. during validation with unknown provider configuration).
+	if req.Plan.Raw.IsNull() || r.data == nil {
+		return
+	}
+
+	// Superseding relies on the latest-version flag from Dependency-Track 4.12.
+	if !r.data.ServerVersion.AtLeast(4, 12) {
+		resp.Diagnostics.AddError(
+			"Unsupported Dependency-Track Version",
+			fmt.Sprintf("dependencytrack_project_version requires Dependency-Track 4.12 or newer, but the configured server reports version %d.%d. "+
+				"Use dependencytrack_project instead or upgrade the server.",
+				r.data.ServerVersion.Major, r.data.ServerVersion.Minor),
+		)
+	}
+}
+
+func (r *ProjectVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProjectVersionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	version := data.Version.ValueString()
+
+	// Creating a duplicate fails with a bare conflict; point at import
+	// instead, which is what adopting an existing version needs.
+	existing, err := r.data.Client.Project.Lookup(ctx, name, version)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up project version, got error: %s", err))
+		return
+	}
+	if err == nil && existing.UUID != uuid.Nil {
+		resp.Diagnostics.AddError(
+			"Project Version Already Exists",
+			fmt.Sprintf("Version %q of project %q already exists (%s). Import it instead of creating it.", version, name, existing.UUID),
+		)
+		return
+	}
+
+	previous, err := latestProjectVersion(ctx, r.data, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up the latest project version, got error: %s", err))
+		return
+	}
+
+	isLatest := true
+	project := dtrack.Project{
+		Name:     name,
+		Version:  version,
+		Active:   true,
+		IsLatest: &isLatest,
+		Tags:     defaultProjectTags(r.data.DefaultTags),
+	}
+
+	switch {
+	case !data.ParentUUID.IsNull() && !data.ParentUUID.IsUnknown():
+		parentUUID, err := uuid.Parse(data.ParentUUID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Parent UUID", fmt.Sprintf("Unable to parse parent UUID: %s", err))
+			return
+		}
+		project.ParentRef = &dtrack.ParentRef{UUID: parentUUID}
+	case previous != nil && previous.ParentRef != nil:
+		project.ParentRef = &dtrack.ParentRef{UUID: previous.ParentRef.UUID}
+	}
+
+	created, err := r.data.Client.Project.Create(ctx, project)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project version, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(created.UUID.String())
+	data.PreviousVersionUUID = types.StringNull()
+	if previous != nil {
+		data.PreviousVersionUUID = types.StringValue(previous.UUID.String())
+	}
+	setProjectVersionState(&data, created)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The version exists from here on, so failing to supersede the previous
+	// one only warns: an error would taint the resource, and recreating it
+	// would conflict with the version just created.
+	if err := clearPreviousLatest(ctx, r.data, previous, created); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Supersede Previous Version",
+			fmt.Sprintf("Version %q was created, but clearing is_latest on version %q (%s) failed: %s", version, previous.Version, previous.UUID, err),
+		)
+	}
+
+	if data.DeactivatePrevious.ValueBool() && previous != nil {
+		err := r.data.API().Do(ctx, http.MethodPatch, "/api/v1/project/"+previous.UUID.String(), map[string]bool{"active": false}, nil)
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Unable to Supersede Previous Version",
+				fmt.Sprintf("Version %q was created, but deactivating version %q (%s) failed: %s", version, previous.Version, previous.UUID, err),
+			)
+		}
+	}
+}
+
+func (r *ProjectVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProjectVersionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	project, err := r.data.Client.Project.Get(ctx, projectUUID)
+	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project version, got error: %s", err))
+		return
+	}
+
+	setProjectVersionState(&data, project)
+
+	// previous_version_uuid is only known for versions created by this
+	// resource, and the Terraform-only settings are unset right after
+	// import; resolve them deterministically.
+	if data.PreviousVersionUUID.IsUnknown() {
+		data.PreviousVersionUUID = types.StringNull()
+	}
+	if data.DeactivatePrevious.IsNull() {
+		data.DeactivatePrevious = types.BoolValue(false)
+	}
+	if data.DeleteOnDestroy.IsNull() {
+		data.DeleteOnDestroy = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProjectVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectVersionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	// Only the parent is stored in Dependency-Track; everything else that
+	// can change in place is Terraform-only. Patch just the parent, since
+	// the full update endpoint would reset every attribute not sent.
+	if !plan.ParentUUID.IsNull() && !plan.ParentUUID.IsUnknown() && !plan.ParentUUID.Equal(state.ParentUUID) {
+		parentUUID, err := uuid.Parse(plan.ParentUUID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Parent UUID", fmt.Sprintf("Unable to parse parent UUID: %s", err))
+			return
+		}
+
+		body := map[string]dtrack.ParentRef{"parent": {UUID: parentUUID}}
+		if err := r.data.API().Do(ctx, http.MethodPatch, "/api/v1/project/"+projectUUID.String(), body, nil); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project version parent, got error: %s", err))
+			return
+		}
+	}
+
+	project, err := r.data.Client.Project.Get(ctx, projectUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project version, got error: %s", err))
+		return
+	}
+	setProjectVersionState(&plan, project)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ProjectVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProjectVersionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Superseded versions stay in Dependency-Track for their history unless
+	// asked otherwise; the resource is just removed from state.
+	if !data.DeleteOnDestroy.ValueBool() {
+		return
+	}
+
+	projectUUID, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid UUID", fmt.Sprintf("Unable to parse project UUID: %s", err))
+		return
+	}
+
+	err = r.data.Client.Project.Delete(ctx, projectUUID)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project version, got error: %s", err))
+		return
+	}
+}
+
+func (r *ProjectVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	projectUUID, err := uuid.Parse(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to parse UUID. Expected a valid UUID, got: %s\nError: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectUUID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("previous_version_uuid"), types.StringNull())...)
+}
+
+// setProjectVersionState records the server-side attributes of project.
+func setProjectVersionState(data *ProjectVersionResourceModel, project dtrack.Project) {
+	data.Name = types.StringValue(project.Name)
+	data.Version = types.StringValue(project.Version)
+	data.IsLatest = types.BoolValue(projectIsLatest(project))
+	data.Active = types.BoolValue(project.Active)
+
+	if project.ParentRef != nil {
+		data.ParentUUID = types.StringValue(project.ParentRef.UUID.String())
+	} else {
+		data.ParentUUID = types.StringNull()
+	}
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// TestAccProjectVersionResource bumps the version and verifies the new
+// version supersedes the old one, which is kept but deactivated.
+func TestAccProjectVersionResource(t *testing.T) {
+	if !testAccServerVersion(t).AtLeast(4, 12) {
+		t.Skip("dependencytrack_project_version requires Dependency-Track 4.12 or newer")
+	}
+
+	name := "Test Project Version " + randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionResourceConfig(name, "1.0.0", false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_version.test",
+						tfjsonpath.New("is_latest"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_version.test",
+						tfjsonpath.New("previous_version_uuid"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_version.test",
+						tfjsonpath.New("parent_uuid"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Bumping the version supersedes 1.0.0, which stays in
+			// Dependency-Track since delete_on_destroy was false
+			{
+				Config: testAccProjectVersionResourceConfig(name, "2.0.0", true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_version.test",
+						tfjsonpath.New("version"),
+						knownvalue.StringExact("2.0.0"),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_version.test",
+						tfjsonpath.New("is_latest"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_project_version.test",
+						tfjsonpath.New("previous_version_uuid"),
+						knownvalue.NotNull(),
+					),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dependencytrack_project_version.test", "parent_uuid",
+						"dependencytrack_project.parent", "id",
+					),
+					testAccCheckProjectVersionSuperseded(t, "dependencytrack_project_version.test"),
+				),
+			},
+			{
+				ResourceName:            "dependencytrack_project_version.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_version_uuid", "deactivate_previous", "delete_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccProjectVersionResource_AlreadyExists(t *testing.T) {
+	if !testAccServerVersion(t).AtLeast(4, 12) {
+		t.Skip("dependencytrack_project_version requires Dependency-Track 4.12 or newer")
+	}
+
+	name := "Test Project Version Exists " + randomSuffix()
+	testAccSeedProject(t, name, "1.0.0")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project_version" "test" {
+  name    = %q
+  version = "1.0.0"
+}
+`, name),
+				ExpectError: regexp.MustCompile(`Import it instead`),
+			},
+		},
+	})
+}
+
+// testAccCheckProjectVersionSuperseded verifies that the previous version of
+// the named resource lost the latest flag and was deactivated, and deletes it
+// once the test is done since the resource keeps it.
+func testAccCheckProjectVersionSuperseded(t *testing.T, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		previousUUID := rs.Primary.Attributes["previous_version_uuid"]
+
+		t.Cleanup(func() {
+			testAccAPIDo(t, http.MethodDelete, "/api/v1/project/"+previousUUID, nil, nil)
+		})
+
+		var previous struct {
+			Version  string `json:"version"`
+			Active   bool   `json:"active"`
+			IsLatest *bool  `json:"isLatest"`
+		}
+		if status := testAccAPIDo(t, http.MethodGet, "/api/v1/project/"+previousUUID, nil, &previous); status != http.StatusOK {
+			return fmt.Errorf("reading previous version %s returned status %d", previousUUID, status)
+		}
+		if previous.Version != "1.0.0" {
+			return fmt.Errorf("expected previous version 1.0.0, got %q", previous.Version)
+		}
+		if previous.Active {
+			return fmt.Errorf("expected previous version to be inactive")
+		}
+		if previous.IsLatest != nil && *previous.IsLatest {
+			return fmt.Errorf("expected previous version to no longer be the latest")
+		}
+		return nil
+	}
+}
+
+// testAccProjectVersionResourceConfig only deletes the version on destroy in
+// the final step, so the replaced version is kept.
+func testAccProjectVersionResourceConfig(name, version string, final bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "parent" {
+  name = "%[1]s Parent"
+}
+
+resource "dependencytrack_project_version" "test" {
+  name                = %[1]q
+  version             = %[2]q
+  parent_uuid         = %[3]t ? null : dependencytrack_project.parent.id
+  deactivate_previous = true
+  delete_on_destroy   = %[3]t
+}
+`, name, version, final)
+}
@@ -3,11 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,10 +29,20 @@ type ProjectViolationsDataSource struct {
 
 // ProjectViolationsDataSourceModel describes the data source data model.
 type ProjectViolationsDataSourceModel struct {
-	ID         types.String            `tfsdk:"id"`
-	Project    types.String            `tfsdk:"project"`
-	Suppressed types.Bool              `tfsdk:"suppressed"`
-	Violations []ProjectViolationModel `tfsdk:"violations"`
+	ID             types.String            `tfsdk:"id"`
+	Project        types.String            `tfsdk:"project"`
+	Suppressed     types.Bool              `tfsdk:"suppressed"`
+	LastOccurrence types.Int64             `tfsdk:"last_occurrence"`
+	StateCounts    types.Map               `tfsdk:"state_counts"`
+	Violations     []ProjectViolationModel `tfsdk:"violations"`
+}
+
+// projectViolation is a policy violation as returned by
+// /api/v1/violation/project/{uuid}. dtrack.PolicyViolation does not carry the
+// timestamp of the violation, so it is decoded alongside.
+type projectViolation struct {
+	dtrack.PolicyViolation
+	Timestamp int64 `json:"timestamp"`
 }
 
 // ProjectViolationModel describes an individual policy violation.
@@ -42,6 +55,7 @@ type ProjectViolationModel struct {
 	ComponentUUID        types.String `tfsdk:"component_uuid"`
 	ComponentName        types.String `tfsdk:"component_name"`
 	ComponentVersion     types.String `tfsdk:"component_version"`
+	OccurredOn           types.Int64  `tfsdk:"occurred_on"`
 }
 
 func (d *ProjectViolationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -65,6 +79,16 @@ func (d *ProjectViolationsDataSource) Schema(ctx context.Context, req datasource
 				Optional:            true,
 				MarkdownDescription: "Whether to include suppressed violations in the result. Defaults to `false`.",
 			},
+			"last_occurrence": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Timestamp (epoch milliseconds) of the most recent violation, or null when there are none",
+			},
+			"state_counts": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				MarkdownDescription: "Number of violations per violation state of the violated policy, keyed by `FAIL`, `WARN` and `INFO`. " +
+					"All three keys are always present.",
+			},
 			"violations": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "List of policy violations",
@@ -102,6 +126,10 @@ func (d *ProjectViolationsDataSource) Schema(ctx context.Context, req datasource
 							Computed:            true,
 							MarkdownDescription: "The version of the component that violates the policy",
 						},
+						"occurred_on": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp (epoch milliseconds) when the violation was detected",
+						},
 					},
 				},
 			},
@@ -142,11 +170,10 @@ func (d *ProjectViolationsDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	suppressed := data.Suppressed.ValueBool()
+	query := url.Values{}
+	query.Set("suppressed", strconv.FormatBool(data.Suppressed.ValueBool()))
 
-	violations, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
-		return d.data.Client.PolicyViolation.GetAllForProject(ctx, projectUUID, suppressed, po)
-	})
+	violations, err := apiGetAllPages[projectViolation](ctx, d.data.API(), "/api/v1/violation/project/"+projectUUID.String(), query)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project policy violations, got error: %s", err))
 		return
@@ -166,6 +193,7 @@ func (d *ProjectViolationsDataSource) Read(ctx context.Context, req datasource.R
 			ComponentUUID:        types.StringValue(v.Component.UUID.String()),
 			ComponentName:        types.StringValue(v.Component.Name),
 			ComponentVersion:     types.StringValue(v.Component.Version),
+			OccurredOn:           types.Int64Value(v.Timestamp),
 		}
 
 		if v.Text != "" {
@@ -180,7 +208,42 @@ func (d *ProjectViolationsDataSource) Read(ctx context.Context, req datasource.R
 		data.Violations = append(data.Violations, item)
 	}
 
+	lastOccurrence, stateCounts := summarizeProjectViolations(violations)
+	data.LastOccurrence = types.Int64PointerValue(lastOccurrence)
+
+	var diags diag.Diagnostics
+	data.StateCounts, diags = types.MapValueFrom(ctx, types.Int64Type, stateCounts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "read a project violations data source")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// summarizeProjectViolations returns the timestamp of the most recent
+// violation (nil when there are none) and the number of violations per
+// violation state of the violated policy. Every state is present in the
+// counts, so callers can index them without a lookup default.
+func summarizeProjectViolations(violations []projectViolation) (*int64, map[string]int64) {
+	counts := map[string]int64{
+		string(dtrack.PolicyViolationStateFail): 0,
+		string(dtrack.PolicyViolationStateWarn): 0,
+		string(dtrack.PolicyViolationStateInfo): 0,
+	}
+
+	var last *int64
+	for i := range violations {
+		v := &violations[i]
+		if last == nil || v.Timestamp > *last {
+			last = &v.Timestamp
+		}
+		if v.PolicyCondition != nil && v.PolicyCondition.Policy != nil {
+			counts[string(v.PolicyCondition.Policy.ViolationState)]++
+		}
+	}
+
+	return last, counts
+}
@@ -2,8 +2,11 @@ package provider
 
 import (
 	"fmt"
+	"maps"
 	"testing"
 
+	dtrack "github.com/DependencyTrack/client-go"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -63,6 +66,25 @@ func TestAccProjectViolationsDataSource(t *testing.T) {
 						tfjsonpath.New("violations").AtSliceIndex(0).AtMapKey("component_uuid"),
 						knownvalue.NotNull(),
 					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_violations.test",
+						tfjsonpath.New("violations").AtSliceIndex(0).AtMapKey("occurred_on"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_violations.test",
+						tfjsonpath.New("last_occurrence"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_violations.test",
+						tfjsonpath.New("state_counts"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"FAIL": knownvalue.Int64Exact(1),
+							"WARN": knownvalue.Int64Exact(0),
+							"INFO": knownvalue.Int64Exact(0),
+						}),
+					),
 				},
 			},
 		},
@@ -85,6 +107,11 @@ func TestAccProjectViolationsDataSource_Empty(t *testing.T) {
 						tfjsonpath.New("violations"),
 						knownvalue.ListSizeExact(0),
 					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_project_violations.test",
+						tfjsonpath.New("last_occurrence"),
+						knownvalue.Null(),
+					),
 				},
 			},
 		},
@@ -111,3 +138,37 @@ data "dependencytrack_project_violations" "test" {
 }
 `, suffix)
 }
+
+func TestSummarizeProjectViolations(t *testing.T) {
+	violation := func(state dtrack.PolicyViolationState, timestamp int64) projectViolation {
+		return projectViolation{
+			PolicyViolation: dtrack.PolicyViolation{
+				PolicyCondition: &dtrack.PolicyCondition{Policy: &dtrack.Policy{ViolationState: state}},
+			},
+			Timestamp: timestamp,
+		}
+	}
+
+	last, counts := summarizeProjectViolations([]projectViolation{
+		violation(dtrack.PolicyViolationStateFail, 1700000000000),
+		violation(dtrack.PolicyViolationStateWarn, 1700000300000),
+		violation(dtrack.PolicyViolationStateFail, 1700000100000),
+		// A violation whose policy was not included counts towards no state.
+		{Timestamp: 1700000200000},
+	})
+
+	if last == nil || *last != 1700000300000 {
+		t.Errorf("last occurrence = %v, want 1700000300000", last)
+	}
+	if want := map[string]int64{"FAIL": 2, "WARN": 1, "INFO": 0}; !maps.Equal(counts, want) {
+		t.Errorf("state counts = %v, want %v", counts, want)
+	}
+
+	last, counts = summarizeProjectViolations(nil)
+	if last != nil {
+		t.Errorf("last occurrence without violations = %d, want nil", *last)
+	}
+	if want := map[string]int64{"FAIL": 0, "WARN": 0, "INFO": 0}; !maps.Equal(counts, want) {
+		t.Errorf("state counts without violations = %v, want %v", counts, want)
+	}
+}
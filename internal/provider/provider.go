@@ -3,14 +3,18 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -22,7 +26,18 @@ type Data struct {
 	ApiKey        string
 	BearerToken   string
 	ServerVersion ServerVersion
+	DefaultTags   []string
 	api           *apiClient
+
+	// transport is the HTTP transport shared by every client of this
+	// provider configuration; see withTransport.
+	transport http.RoundTripper
+
+	// DefaultProjectActive and DefaultProjectClassifier are used by
+	// dependencytrack_project when active or classifier is not configured.
+	// An empty DefaultProjectClassifier leaves the classifier to the server.
+	DefaultProjectActive     bool
+	DefaultProjectClassifier string
 }
 
 // IsV5 reports whether the configured Dependency-Track server is running
@@ -37,6 +52,14 @@ func (d *Data) API() *apiClient {
 	return d.api
 }
 
+// withTransport returns a client option that sends a dtrack client's requests
+// through transport. The authentication options wrap the HTTP client's
+// transport, so each dtrack client needs its own http.Client, and this option
+// has to come before them.
+func withTransport(transport http.RoundTripper) dtrack.ClientOption {
+	return dtrack.WithHttpClient(&http.Client{Timeout: dtrack.DefaultTimeout, Transport: transport})
+}
+
 // Ensure DependencyTrackProvider satisfies various provider interfaces.
 var _ provider.Provider = &DependencyTrackProvider{}
 var _ provider.ProviderWithFunctions = &DependencyTrackProvider{}
@@ -52,10 +75,17 @@ type DependencyTrackProvider struct {
 
 // DependencyTrackProviderModel describes the provider data model.
 type DependencyTrackProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	ApiKey   types.String `tfsdk:"api_key"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Endpoint    types.String `tfsdk:"endpoint"`
+	ApiKey      types.String `tfsdk:"api_key"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	DefaultTags types.Set    `tfsdk:"default_tags"`
+
+	DefaultProjectActive     types.Bool   `tfsdk:"default_project_active"`
+	DefaultProjectClassifier types.String `tfsdk:"default_project_classifier"`
+
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+	SkipHealthCheck       types.Bool  `tfsdk:"skip_health_check"`
 }
 
 func (p *DependencyTrackProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -89,6 +119,42 @@ func (p *DependencyTrackProvider) Schema(ctx context.Context, req provider.Schem
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags added to every project created by `dependencytrack_project`. " +
+					"The tags are applied when the project is created and carried over on later updates, " +
+					"but are not tracked as part of the project's configuration, so they never show up as drift. " +
+					"A default tag that is removed from a project outside of Terraform stays removed.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_project_active": schema.BoolAttribute{
+				MarkdownDescription: "Value of `active` for `dependencytrack_project` resources that do not set it. Defaults to `true`.",
+				Optional:            true,
+			},
+			"default_project_classifier": schema.StringAttribute{
+				MarkdownDescription: "Value of `classifier` for `dependencytrack_project` resources that do not set it, e.g. `APPLICATION`. " +
+					"When unset, such projects get the classifier Dependency-Track assigns.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectClassifiers...),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of HTTP requests the provider sends to Dependency-Track at the same time, " +
+					"shared across all resources and data sources. Useful to avoid overloading the server when Terraform " +
+					"refreshes many resources in parallel. Unlimited when unset.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"skip_health_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip checking at configure time that the server accepts the `api_key`, " +
+					"e.g. when planning against a server the key is not yet valid for. A rejected key then only fails the first resource operation. " +
+					"This does not allow planning offline: the server version is still detected at configure time, " +
+					"and the Dependency-Track client library cannot be created without reaching `GET /api/version`. Defaults to `false`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -156,6 +222,17 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 		return
 	}
 
+	// All HTTP traffic (both the client library and the raw API client) goes
+	// through a single transport so that max_concurrent_requests caps the
+	// provider as a whole rather than each client separately.
+	var transport http.RoundTripper = http.DefaultTransport
+	if !data.MaxConcurrentRequests.IsNull() && !data.MaxConcurrentRequests.IsUnknown() {
+		transport = newLimitedTransport(int(data.MaxConcurrentRequests.ValueInt64()), transport)
+	}
+	// Conflict retries wrap the limiter, so a request waiting to be retried
+	// doesn't hold a slot.
+	transport = newConflictRetryTransport(transport)
+
 	var client *dtrack.Client
 	var apiKey string
 	var bearerToken string
@@ -165,7 +242,7 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 	if hasApiKey {
 		// Use API key authentication
 		apiKey = data.ApiKey.ValueString()
-		client, err = dtrack.NewClient(data.Endpoint.ValueString(), dtrack.WithAPIKey(apiKey))
+		client, err = dtrack.NewClient(data.Endpoint.ValueString(), withTransport(transport), dtrack.WithAPIKey(apiKey))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Create Dependency-Track Client",
@@ -177,7 +254,7 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 	} else {
 		// Use username/password authentication - login to get the bearer token
 		// Create a temporary client to perform the login
-		tempClient, err := dtrack.NewClient(data.Endpoint.ValueString())
+		tempClient, err := dtrack.NewClient(data.Endpoint.ValueString(), withTransport(transport))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Create Temporary Client",
@@ -199,7 +276,7 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 		}
 
 		// Create an authenticated client with the bearer token
-		client, err = dtrack.NewClient(data.Endpoint.ValueString(), dtrack.WithBearerToken(bearerToken))
+		client, err = dtrack.NewClient(data.Endpoint.ValueString(), withTransport(transport), dtrack.WithBearerToken(bearerToken))
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to Create Dependency-Track Client",
@@ -213,7 +290,8 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 	// Detect the Dependency-Track server version via the unauthenticated
 	// GET /api/version endpoint (exposed by both v4 and v5). This is required
 	// (no silent fallback) since resource behavior diverges between major
-	// versions starting in a later task.
+	// versions starting in a later task. skip_health_check does not skip it:
+	// dtrack.NewClient above already fails when the endpoint is unreachable.
 	about, err := client.About.Get(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -244,6 +322,14 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 		"major":   serverVersion.Major,
 	})
 
+	var defaultTags []string
+	if !data.DefaultTags.IsNull() && !data.DefaultTags.IsUnknown() {
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Create provider data with client and API configuration
 	providerData := &Data{
 		Client:        client,
@@ -251,12 +337,41 @@ func (p *DependencyTrackProvider) Configure(ctx context.Context, req provider.Co
 		ApiKey:        apiKey,
 		BearerToken:   bearerToken,
 		ServerVersion: serverVersion,
-		api:           newAPIClient(data.Endpoint.ValueString(), apiKey, bearerToken),
+		DefaultTags:   defaultTags,
+		api:           newAPIClient(data.Endpoint.ValueString(), apiKey, bearerToken, transport),
+		transport:     transport,
+
+		DefaultProjectActive:     data.DefaultProjectActive.IsNull() || data.DefaultProjectActive.ValueBool(),
+		DefaultProjectClassifier: data.DefaultProjectClassifier.ValueString(),
 	}
 
-	// Make the provider data available to data sources and resources
+	// The version probe is unauthenticated and a wrong API key would only
+	// surface on the first resource operation, so check the key up front.
+	// Username/password credentials were already checked by the login.
+	if hasApiKey && !data.SkipHealthCheck.ValueBool() {
+		if err := checkAPIKey(ctx, providerData.API()); err != nil {
+			if apiErrorStatusCode(err) == http.StatusUnauthorized {
+				resp.Diagnostics.AddError(
+					"Invalid API Key",
+					"Dependency-Track at "+data.Endpoint.ValueString()+" rejected the configured api_key. "+
+						"Check that the key is correct and has not been revoked or rotated.",
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Dependency-Track Health Check Failed",
+				"Unable to verify the api_key against "+data.Endpoint.ValueString()+". "+
+					"Set skip_health_check = true to configure the provider without this check. Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Make the provider data available to data sources, resources and
+	// ephemeral resources
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
 }
 
 func (p *DependencyTrackProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -264,11 +379,17 @@ func (p *DependencyTrackProvider) Resources(ctx context.Context) []func() resour
 		NewTeamResource,
 		NewManagedUserResource,
 		NewConfigPropertyResource,
+		NewConfigPropertiesResource,
+		NewSMTPConfigResource,
+		NewJiraConfigResource,
 		NewProjectResource,
+		NewProjectVersionResource,
 		NewTeamPermissionsResource,
 		NewManagedUserPermissionsResource,
 		NewPolicyResource,
 		NewACLMappingResource,
+		NewTeamACLResource,
+		NewACLConfigResource,
 		NewTeamAPIKeyResource,
 		NewUserTeamMembershipResource,
 		NewProjectPolicyResource,
@@ -281,11 +402,13 @@ func (p *DependencyTrackProvider) Resources(ctx context.Context) []func() resour
 		NewTagResource,
 		NewLicenseGroupResource,
 		NewProjectPropertyResource,
+		NewProjectAnalysesResource,
 		NewOIDCGroupMappingResource,
 		NewLDAPMappingResource,
 		NewLicenseResource,
 		NewLicenseGroupLicenseResource,
 		NewPolicyTagResource,
+		NewProjectTagResource,
 		NewNotificationRuleTagResource,
 		NewSecretResource,
 		NewExtensionConfigResource,
@@ -293,33 +416,52 @@ func (p *DependencyTrackProvider) Resources(ctx context.Context) []func() resour
 }
 
 func (p *DependencyTrackProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewTeamAPIKeyEphemeralResource,
+		NewAuthTokenEphemeralResource,
+	}
 }
 
 func (p *DependencyTrackProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAboutDataSource,
 		NewTeamDataSource,
+		NewTeamsDataSource,
 		NewManagedUserDataSource,
+		NewManagedUsersDataSource,
+		NewPermissionDataSource,
 		NewConfigPropertyDataSource,
 		NewProjectDataSource,
 		NewPolicyDataSource,
+		NewPoliciesDataSource,
 		NewTeamAPIKeysDataSource,
 		NewNotificationPublisherDataSource,
 		NewRepositoriesDataSource,
 		NewOIDCGroupDataSource,
+		NewOIDCGroupsDataSource,
 		NewTagsDataSource,
 		NewLicenseGroupDataSource,
+		NewCWEDataSource,
 		NewLicenseDataSource,
 		NewLicensesDataSource,
 		NewPortfolioMetricsDataSource,
 		NewProjectMetricsDataSource,
 		NewProjectViolationsDataSource,
 		NewProjectFindingsDataSource,
+		NewProjectComponentsDataSource,
+		NewProjectServicesDataSource,
+		NewProjectBOMDataSource,
+		NewNotificationRuleDataSource,
+		NewProjectPropertyDataSource,
+		NewACLMappingsDataSource,
 	}
 }
 
 func (p *DependencyTrackProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewWebhookConfigFunction,
+		NewSlackConfigFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -329,3 +471,14 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// checkAPIKey verifies that the server accepts the API key api authenticates
+// with, by reading the team the key belongs to. Servers without that endpoint
+// are not checked.
+func checkAPIKey(ctx context.Context, api *apiClient) error {
+	err := api.Do(ctx, http.MethodGet, "/api/v1/team/self", nil, nil)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"regexp"
 	"strings"
 	"sync"
 	"testing"
@@ -29,7 +32,7 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 // It allows for testing assertions on data returned by an ephemeral resource during Open.
 // The echoprovider is used to arrange tests by echoing ephemeral data into the Terraform state.
 // This lets the data be referenced in test assertions with state checks.
-var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){ // nolint:unused
+var testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
 	"dependencytrack": providerserver.NewProtocol6WithError(New("test")()),
 	"echo":            echoprovider.NewProviderServer(),
 }
@@ -79,7 +82,8 @@ func testAccPreCheckAPIKey(t *testing.T) {
 
 // testAccPreCheckUsernamePassword checks that username/password authentication is available.
 // It gates TestAccProviderAuth_UsernamePassword, the only test that authenticates
-// with username/password rather than an API key.
+// the provider with username/password rather than an API key, and
+// TestAccAuthTokenEphemeralResource, which logs in with the same credentials.
 func testAccPreCheckUsernamePassword(t *testing.T) {
 	if v := os.Getenv("DEPENDENCYTRACK_ENDPOINT"); v == "" {
 		t.Skip("DEPENDENCYTRACK_ENDPOINT must be set for acceptance tests")
@@ -123,6 +127,64 @@ data "dependencytrack_team" "administrators" {
 	})
 }
 
+func TestAccProvider_InvalidAPIKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "dependencytrack" {
+  endpoint = %q
+  api_key  = "odt_not-a-valid-key"
+}
+
+data "dependencytrack_team" "administrators" {
+  name = "Administrators"
+}
+`, os.Getenv("DEPENDENCYTRACK_ENDPOINT")),
+				ExpectError: regexp.MustCompile(`Invalid API Key`),
+			},
+		},
+	})
+}
+
+func TestCheckAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		wantStatus int
+	}{
+		{name: "accepted", status: http.StatusOK},
+		{name: "rejected", status: http.StatusUnauthorized, wantStatus: http.StatusUnauthorized},
+		{name: "endpoint missing", status: http.StatusNotFound},
+		{name: "server error", status: http.StatusInternalServerError, wantStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/team/self" || r.Header.Get("X-Api-Key") != "test-key" {
+					t.Errorf("unexpected request %s with X-Api-Key %q", r.URL.Path, r.Header.Get("X-Api-Key"))
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			err := checkAPIKey(context.Background(), newAPIClient(srv.URL, "test-key", "", nil))
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Errorf("checkAPIKey() = %v, want nil", err)
+				}
+				return
+			}
+			if got := apiErrorStatusCode(err); got != tt.wantStatus {
+				t.Errorf("checkAPIKey() status = %d, want %d (err %v)", got, tt.wantStatus, err)
+			}
+		})
+	}
+}
+
 // State backing testAccServerVersion below.
 var (
 	testAccServerVersionOnce  sync.Once
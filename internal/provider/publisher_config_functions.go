@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ function.Function = &WebhookConfigFunction{}
+	_ function.Function = &SlackConfigFunction{}
+)
+
+func NewWebhookConfigFunction() function.Function {
+	return &WebhookConfigFunction{}
+}
+
+// WebhookConfigFunction builds the publisher_config of a rule that uses the
+// Outbound Webhook publisher on Dependency-Track v4. Provider functions run
+// without the configured provider, so they cannot adapt to the server version.
+type WebhookConfigFunction struct{}
+
+func (f *WebhookConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "webhook_config"
+}
+
+func (f *WebhookConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the publisher_config of an outbound webhook notification rule",
+		MarkdownDescription: "Returns the `publisher_config` JSON for a `dependencytrack_notification_rule` that uses the Outbound Webhook publisher, " +
+			"in the canonical form Dependency-Track stores, so the rule shows no drift after apply. " +
+			"The result uses the Dependency-Track v4 format (`{\"destination\": ...}`); the v5 webhook extension expects `destinationUrl` instead, " +
+			"so build the JSON with `jsonencode` when targeting v5.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "destination",
+				MarkdownDescription: "The absolute URL notifications are posted to",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *WebhookConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var destination string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &destination))
+	if resp.Error != nil {
+		return
+	}
+
+	config, funcErr := destinationPublisherConfig(0, destination)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, config))
+}
+
+func NewSlackConfigFunction() function.Function {
+	return &SlackConfigFunction{}
+}
+
+// SlackConfigFunction builds the publisher_config of a rule that uses the
+// Slack publisher on Dependency-Track v4. Dependency-Track posts to a Slack incoming webhook, whose
+// URL already determines the channel and carries the credentials, so the
+// webhook URL is the only setting.
+type SlackConfigFunction struct{}
+
+func (f *SlackConfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "slack_config"
+}
+
+func (f *SlackConfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the publisher_config of a Slack notification rule",
+		MarkdownDescription: "Returns the `publisher_config` JSON for a `dependencytrack_notification_rule` that uses the Slack publisher, " +
+			"in the canonical form Dependency-Track stores, so the rule shows no drift after apply. " +
+			"Dependency-Track publishes to a Slack [incoming webhook](https://api.slack.com/messaging/webhooks); " +
+			"the target channel and the credentials are part of the webhook URL, so there is no separate channel or token argument. " +
+			"The result uses the Dependency-Track v4 format; v5 validates `publisher_config` against the Slack extension's own schema, " +
+			"so build the JSON with `jsonencode` when targeting v5.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "webhook_url",
+				MarkdownDescription: "The Slack incoming webhook URL, e.g. `https://hooks.slack.com/services/...`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SlackConfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var webhookURL string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &webhookURL))
+	if resp.Error != nil {
+		return
+	}
+
+	config, funcErr := destinationPublisherConfig(0, webhookURL)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, config))
+}
+
+// destinationPublisherConfig returns the publisher config of the publishers
+// that only take a destination URL, rejecting anything that isn't an absolute
+// http(s) URL. argument is the position of the URL in the function call, for
+// error reporting. HTML escaping is turned off so query strings stay readable
+// in plans; the notification rule resource compares publisher_config
+// semantically, so this does not cause drift.
+func destinationPublisherConfig(argument int64, destination string) (string, *function.FuncError) {
+	u, err := url.Parse(destination)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", function.NewArgumentFuncError(argument, "must be an absolute http or https URL, got: "+destination)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(map[string]string{"destination": destination}); err != nil {
+		return "", function.NewFuncError("Unable to encode publisher config: " + err.Error())
+	}
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n"))), nil
+}
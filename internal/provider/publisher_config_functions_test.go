@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runStringFunction calls f with a single string argument the way Terraform
+// does and returns its response.
+func runStringFunction(f function.Function, argument string) function.RunResponse {
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(argument)}),
+	}, &resp)
+	return resp
+}
+
+func TestWebhookConfigFunction(t *testing.T) {
+	tests := map[string]struct {
+		destination string
+		want        string
+		wantErr     *function.FuncError
+	}{
+		"query string": {
+			destination: "https://hooks.example.com/dtrack?team=a&env=b",
+			want:        `{"destination":"https://hooks.example.com/dtrack?team=a&env=b"}`,
+		},
+		"http": {
+			destination: "http://hooks.example.com/dtrack",
+			want:        `{"destination":"http://hooks.example.com/dtrack"}`,
+		},
+		"invalid destination": {
+			destination: "hooks.example.com/dtrack",
+			wantErr:     function.NewArgumentFuncError(0, "must be an absolute http or https URL, got: hooks.example.com/dtrack"),
+		},
+		"unsupported scheme": {
+			destination: "ftp://hooks.example.com/dtrack",
+			wantErr:     function.NewArgumentFuncError(0, "must be an absolute http or https URL, got: ftp://hooks.example.com/dtrack"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runStringFunction(NewWebhookConfigFunction(), tt.destination)
+			if !resp.Error.Equal(tt.wantErr) {
+				t.Fatalf("got error %v, want %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if want := function.NewResultData(types.StringValue(tt.want)); !resp.Result.Equal(want) {
+				t.Errorf("got result %s, want %s", resp.Result.Value(), want.Value())
+			}
+		})
+	}
+}
+
+func TestSlackConfigFunction(t *testing.T) {
+	tests := map[string]struct {
+		webhookURL string
+		want       string
+		wantErr    *function.FuncError
+	}{
+		"incoming webhook": {
+			webhookURL: "https://hooks.slack.com/services/T000/B000/XXXX",
+			want:       `{"destination":"https://hooks.slack.com/services/T000/B000/XXXX"}`,
+		},
+		"invalid webhook URL": {
+			webhookURL: "hooks.slack.com/services/T000/B000/XXXX",
+			wantErr:    function.NewArgumentFuncError(0, "must be an absolute http or https URL, got: hooks.slack.com/services/T000/B000/XXXX"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runStringFunction(NewSlackConfigFunction(), tt.webhookURL)
+			if !resp.Error.Equal(tt.wantErr) {
+				t.Fatalf("got error %v, want %v", resp.Error, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if want := function.NewResultData(types.StringValue(tt.want)); !resp.Result.Equal(want) {
+				t.Errorf("got result %s, want %s", resp.Result.Value(), want.Value())
+			}
+		})
+	}
+}
@@ -158,7 +158,7 @@ func (r *SecretResource) Create(ctx context.Context, req resource.CreateRequest,
 			)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret, got error: %s", err))
+		addAPIErrorDiagnostic(&resp.Diagnostics, "create secret", err)
 		return
 	}
 
@@ -189,7 +189,7 @@ func (r *SecretResource) Read(ctx context.Context, req resource.ReadRequest, res
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret, got error: %s", err))
+		addAPIErrorDiagnostic(&resp.Diagnostics, "read secret", err)
 		return
 	}
 
@@ -230,7 +230,7 @@ func (r *SecretResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	err := r.data.API().Do(ctx, http.MethodPatch, "/api/v2/secrets/"+url.PathEscape(data.Name.ValueString()), updateReq, nil)
 	if err != nil && !isNotModified(err) {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret, got error: %s", err))
+		addAPIErrorDiagnostic(&resp.Diagnostics, "update secret", err)
 		return
 	}
 
@@ -254,7 +254,7 @@ func (r *SecretResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	err := r.data.API().Do(ctx, http.MethodDelete, "/api/v2/secrets/"+url.PathEscape(data.Name.ValueString()), nil, nil)
 	if err != nil && !isNotFound(err) {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret, got error: %s", err))
+		addAPIErrorDiagnostic(&resp.Diagnostics, "delete secret", err)
 		return
 	}
 }
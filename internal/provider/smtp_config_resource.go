@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SMTPConfigResource{}
+var _ resource.ResourceWithImportState = &SMTPConfigResource{}
+
+// Keys of the email config properties managed by dependencytrack_smtp_config,
+// in the group_name/property_name format of the config_properties resource.
+const (
+	smtpEnabledKey     = "email/smtp.enabled"
+	smtpServerKey      = "email/smtp.server.hostname"
+	smtpPortKey        = "email/smtp.server.port"
+	smtpUsernameKey    = "email/smtp.username"
+	smtpPasswordKey    = "email/smtp.password"
+	smtpFromAddressKey = "email/smtp.from.address"
+	smtpUseTLSKey      = "email/smtp.ssltls"
+)
+
+func NewSMTPConfigResource() resource.Resource {
+	return &SMTPConfigResource{}
+}
+
+// SMTPConfigResource defines the resource implementation.
+type SMTPConfigResource struct {
+	data *Data
+}
+
+// SMTPConfigResourceModel describes the resource data model.
+type SMTPConfigResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	Server      types.String `tfsdk:"server"`
+	Port        types.Int64  `tfsdk:"port"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	FromAddress types.String `tfsdk:"from_address"`
+	UseTLS      types.Bool   `tfsdk:"use_tls"`
+}
+
+func (r *SMTPConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_smtp_config"
+}
+
+func (r *SMTPConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the SMTP settings Dependency-Track uses to send notification emails, " +
+			"i.e. the `smtp.*` config properties of the `email` group, written in a single update. " +
+			"When destroyed, the settings are only removed from Terraform state and keep their current values. " +
+			"Do not manage the same properties with `dependencytrack_config_property` or `dependencytrack_config_properties`. " +
+			"Only available on Dependency-Track v4; v5 configures email through the email notification publisher extension (see `dependencytrack_extension_config`).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the resource (always `smtp_config`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Whether email sending is enabled. Defaults to `true`.",
+			},
+			"server": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Hostname of the SMTP server",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Port of the SMTP server. When unset, the port currently configured in Dependency-Track is kept.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Username to authenticate with. Leave unset for servers that don't require authentication.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				MarkdownDescription: "Password to authenticate with. Dependency-Track stores it encrypted and never returns it, " +
+					"so changes made outside of Terraform are not detected.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"from_address": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Email address notifications are sent from",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"use_tls": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Whether to connect to the SMTP server over SSL/TLS. When unset, the current setting in Dependency-Track is kept.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SMTPConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *SMTPConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SMTPConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("smtp_config")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SMTPConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SMTPConfigResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !requireV4(r.data, "dependencytrack_smtp_config", smtpConfigV5Hint, &resp.Diagnostics) {
+		return
+	}
+
+	all, err := r.data.Client.Config.GetAll(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config properties, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setSMTPConfigState(&data, configPropertyValues(all, smtpConfigKnownValues(data)))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = types.StringValue("smtp_config")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SMTPConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SMTPConfigResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SMTPConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Config properties cannot be deleted from Dependency-Track; the SMTP
+	// settings keep their current values once the resource is removed from
+	// state.
+}
+
+func (r *SMTPConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is a single SMTP configuration, so any import ID adopts it.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "smtp_config")...)
+}
+
+// smtpConfigV5Hint tells v5 users where the SMTP settings moved to.
+const smtpConfigV5Hint = "On Dependency-Track v5, configure email through the email notification publisher extension " +
+	"with dependencytrack_extension_config instead."
+
+// apply writes the configured SMTP settings and records the values stored by
+// the server in data.
+func (r *SMTPConfigResource) apply(ctx context.Context, data *SMTPConfigResourceModel, diags *diag.Diagnostics) {
+	if !requireV4(r.data, "dependencytrack_smtp_config", smtpConfigV5Hint, diags) {
+		return
+	}
+
+	all := updateConfigProperties(ctx, r.data.Client, smtpConfigProperties(*data), diags)
+	if diags.HasError() {
+		return
+	}
+
+	diags.Append(setSMTPConfigState(data, configPropertyValues(all, smtpConfigKnownValues(*data)))...)
+}
+
+// smtpConfigProperties returns the config property values for data. Unset
+// username and password are written as empty, so removing them from the
+// configuration turns authentication off; unset port and use_tls are left
+// alone.
+func smtpConfigProperties(data SMTPConfigResourceModel) map[string]string {
+	properties := map[string]string{
+		smtpEnabledKey:     strconv.FormatBool(data.Enabled.ValueBool()),
+		smtpServerKey:      data.Server.ValueString(),
+		smtpUsernameKey:    data.Username.ValueString(),
+		smtpPasswordKey:    data.Password.ValueString(),
+		smtpFromAddressKey: data.FromAddress.ValueString(),
+	}
+	if !data.Port.IsNull() && !data.Port.IsUnknown() {
+		properties[smtpPortKey] = strconv.FormatInt(data.Port.ValueInt64(), 10)
+	}
+	if !data.UseTLS.IsNull() && !data.UseTLS.IsUnknown() {
+		properties[smtpUseTLSKey] = strconv.FormatBool(data.UseTLS.ValueBool())
+	}
+	return properties
+}
+
+// smtpConfigKnownValues lists every managed property for configPropertyValues,
+// so all of them are read back. The password in data stands in for the
+// placeholder the server returns instead of the real value.
+func smtpConfigKnownValues(data SMTPConfigResourceModel) map[string]string {
+	return map[string]string{
+		smtpEnabledKey:     "",
+		smtpServerKey:      "",
+		smtpPortKey:        "",
+		smtpUsernameKey:    "",
+		smtpPasswordKey:    data.Password.ValueString(),
+		smtpFromAddressKey: "",
+		smtpUseTLSKey:      "",
+	}
+}
+
+// setSMTPConfigState converts the config property values in values into the
+// typed attributes of data. Properties missing from values keep the value
+// already in data, and empty values become null.
+func setSMTPConfigState(data *SMTPConfigResourceModel, values map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	setString := func(key string, attr *types.String) {
+		value, ok := values[key]
+		if !ok {
+			return
+		}
+		if value == "" {
+			*attr = types.StringNull()
+			return
+		}
+		*attr = types.StringValue(value)
+	}
+
+	setBool := func(key string, attr *types.Bool) {
+		value, ok := values[key]
+		if !ok {
+			return
+		}
+		if value == "" {
+			*attr = types.BoolNull()
+			return
+		}
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddError("Unexpected Config Property Value", fmt.Sprintf("Config property %s has the non-boolean value %q", key, value))
+			return
+		}
+		*attr = types.BoolValue(b)
+	}
+
+	setString(smtpServerKey, &data.Server)
+	setString(smtpUsernameKey, &data.Username)
+	setString(smtpPasswordKey, &data.Password)
+	setString(smtpFromAddressKey, &data.FromAddress)
+	setBool(smtpEnabledKey, &data.Enabled)
+	setBool(smtpUseTLSKey, &data.UseTLS)
+
+	if value, ok := values[smtpPortKey]; ok {
+		if value == "" {
+			data.Port = types.Int64Null()
+		} else if port, err := strconv.ParseInt(value, 10, 64); err != nil {
+			diags.AddError("Unexpected Config Property Value", fmt.Sprintf("Config property %s has the non-numeric value %q", smtpPortKey, value))
+		} else {
+			data.Port = types.Int64Value(port)
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// TestAccSMTPConfigResource is gated to Dependency-Track v4, the only major
+// version with SMTP config properties.
+func TestAccSMTPConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t); testAccSkipUnlessV4(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_smtp_config" "test" {
+  server       = "smtp.example.com"
+  port         = 587
+  from_address = "dtrack@example.com"
+  username     = "dtrack"
+  password     = "initial-password"
+  use_tls      = true
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("enabled"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("server"), knownvalue.StringExact("smtp.example.com")),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("port"), knownvalue.Int64Exact(587)),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("password"), knownvalue.StringExact("initial-password")),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("use_tls"), knownvalue.Bool(true)),
+				},
+			},
+			{
+				ResourceName:            "dependencytrack_smtp_config.test",
+				ImportState:             true,
+				ImportStateId:           "smtp_config",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+			// Dropping authentication clears the credentials; port and
+			// use_tls are no longer configured and keep their values.
+			{
+				Config: testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_smtp_config" "test" {
+  enabled      = false
+  server       = "smtp2.example.com"
+  from_address = "dtrack@example.com"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("enabled"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("server"), knownvalue.StringExact("smtp2.example.com")),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("username"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("password"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("port"), knownvalue.Int64Exact(587)),
+					statecheck.ExpectKnownValue("dependencytrack_smtp_config.test", tfjsonpath.New("use_tls"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestSMTPConfigProperties(t *testing.T) {
+	data := SMTPConfigResourceModel{
+		Enabled:     types.BoolValue(true),
+		Server:      types.StringValue("smtp.example.com"),
+		Port:        types.Int64Unknown(),
+		Username:    types.StringNull(),
+		Password:    types.StringNull(),
+		FromAddress: types.StringValue("dtrack@example.com"),
+		UseTLS:      types.BoolValue(false),
+	}
+
+	got := smtpConfigProperties(data)
+	want := map[string]string{
+		smtpEnabledKey:     "true",
+		smtpServerKey:      "smtp.example.com",
+		smtpUsernameKey:    "",
+		smtpPasswordKey:    "",
+		smtpFromAddressKey: "dtrack@example.com",
+		smtpUseTLSKey:      "false",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestSetSMTPConfigState(t *testing.T) {
+	data := SMTPConfigResourceModel{
+		Port:   types.Int64Unknown(),
+		UseTLS: types.BoolUnknown(),
+	}
+
+	diags := setSMTPConfigState(&data, map[string]string{
+		smtpEnabledKey:     "true",
+		smtpServerKey:      "smtp.example.com",
+		smtpPortKey:        "465",
+		smtpUsernameKey:    "",
+		smtpPasswordKey:    "",
+		smtpFromAddressKey: "dtrack@example.com",
+		smtpUseTLSKey:      "true",
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !data.Enabled.ValueBool() || !data.UseTLS.ValueBool() {
+		t.Errorf("enabled/use_tls = %v/%v, want true/true", data.Enabled, data.UseTLS)
+	}
+	if data.Port.ValueInt64() != 465 {
+		t.Errorf("port = %v, want 465", data.Port)
+	}
+	if !data.Username.IsNull() || !data.Password.IsNull() {
+		t.Errorf("username/password = %v/%v, want null", data.Username, data.Password)
+	}
+	if data.Server.ValueString() != "smtp.example.com" || data.FromAddress.ValueString() != "dtrack@example.com" {
+		t.Errorf("server/from_address = %v/%v", data.Server, data.FromAddress)
+	}
+
+	if diags := setSMTPConfigState(&data, map[string]string{smtpPortKey: "not-a-port"}); !diags.HasError() {
+		t.Error("expected an error for a non-numeric port")
+	}
+}
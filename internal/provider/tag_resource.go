@@ -6,9 +6,12 @@ import (
 	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -18,6 +21,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TagResource{}
 var _ resource.ResourceWithImportState = &TagResource{}
+var _ resource.ResourceWithModifyPlan = &TagResource{}
 
 func NewTagResource() resource.Resource {
 	return &TagResource{}
@@ -32,6 +36,8 @@ type TagResource struct {
 type TagResourceModel struct {
 	ID   types.String `tfsdk:"id"`
 	Name types.String `tfsdk:"name"`
+
+	ForceDestroy types.Bool `tfsdk:"force_destroy"`
 }
 
 func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,7 +46,7 @@ func (r *TagResource) Metadata(ctx context.Context, req resource.MetadataRequest
 
 func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a tag in Dependency-Track. Tags have no UUID; the tag name is its identifier.",
+		MarkdownDescription: "Manages a tag in Dependency-Track. Tags have no UUID; the tag name is its identifier. Reference the tag from `dependencytrack_policy_tag` or `dependencytrack_notification_rule_tag` to attach it to policies or notification rules.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -51,12 +57,23 @@ func (r *TagResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 			"name": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "The name of the tag. Dependency-Track normalizes tag names to lowercase, so a mixed-case name is matched case-insensitively (using a lowercase name is recommended). Changing this forces a new resource to be created.",
+				Required: true,
+				MarkdownDescription: "The name of the tag. Dependency-Track normalizes tag names to lowercase, so a mixed-case name is matched case-insensitively (using a lowercase name is recommended). " +
+					"Dependency-Track cannot rename tags, so changing this deletes the tag and creates a new one: projects, policies and notification rules " +
+					"tagged with the old name are not moved to the new one and must be re-tagged.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying the tag first removes it from every project, policy and notification rule it is attached to. " +
+					"Otherwise the tag is deleted directly, which Dependency-Track allows as long as the caller can access everything the tag is attached to; " +
+					"when it rejects the delete, the error lists what the tag is still attached to. " +
+					"This is a Terraform-only setting that is not stored in Dependency-Track. Defaults to `false`.",
+			},
 		},
 	}
 }
@@ -128,12 +145,48 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data.ID = types.StringValue(name)
 	data.Name = types.StringValue(name)
 
+	// force_destroy only lives in state; right after import it is unset and
+	// resolves to its default.
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// Update is a no-op: the only configurable attribute (name) forces
-// replacement, so this method is never invoked in practice. It is implemented
-// only to satisfy the resource.Resource interface.
+// ModifyPlan warns when a name change is planned: it replaces the tag, which
+// does not carry the tag's associations over to the new name.
+func (r *TagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.IsUnknown() || plan.Name.Equal(state.Name) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("name"),
+		"Tag Rename Replaces the Tag",
+		fmt.Sprintf("Dependency-Track has no endpoint to rename a tag, so changing the name from %q to %q deletes the tag and creates a new one. "+
+			"Projects, policies and notification rules tagged %q are not tagged %q afterwards; re-tag them, e.g. by updating the dependencytrack_project_tag, "+
+			"dependencytrack_policy_tag and dependencytrack_notification_rule_tag resources that reference this tag.",
+			state.Name.ValueString(), plan.Name.ValueString(), state.Name.ValueString(), plan.Name.ValueString()),
+	)
+}
+
+// Update only records force_destroy, the one attribute that can change
+// without replacing the tag.
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data TagResourceModel
 
@@ -155,11 +208,24 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	// Dependency-Track stores tag names lowercased and its delete endpoint
-	// requires the exact stored name, so normalize before deleting.
-	err := r.data.Client.Tag.Delete(ctx, []string{strings.ToLower(data.Name.ValueString())})
+	// Dependency-Track stores tag names lowercased and its endpoints require
+	// the exact stored name, so normalize before detaching and deleting.
+	name := strings.ToLower(data.Name.ValueString())
+
+	if data.ForceDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.removeReferences(ctx, name)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.data.Client.Tag.Delete(ctx, []string{name})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s", err))
+		// A tag that was already removed out-of-band leaves nothing to delete.
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tag, got error: %s%s", err, r.describeReferences(ctx, name)))
 		return
 	}
 
@@ -192,3 +258,99 @@ func (r *TagResource) tagExists(ctx context.Context, name string) (bool, error)
 
 	return false, nil
 }
+
+// tagReferences returns the projects, policies and notification rules the
+// tag is attached to.
+func (r *TagResource) tagReferences(ctx context.Context, name string) ([]dtrack.TaggedProjectListResponseItem, []dtrack.TaggedPolicyListResponseItem, []dtrack.TaggedPolicyListResponseItem, error) {
+	projects, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedProjectListResponseItem], error) {
+		return r.data.Client.Tag.GetProjects(ctx, name, po, dtrack.SortOptions{})
+	})
+	if err != nil && !isNotFound(err) {
+		return nil, nil, nil, fmt.Errorf("unable to read tagged projects: %w", err)
+	}
+
+	policies, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
+		return r.data.Client.Tag.GetPolicies(ctx, name, po, dtrack.SortOptions{})
+	})
+	if err != nil && !isNotFound(err) {
+		return nil, nil, nil, fmt.Errorf("unable to read tagged policies: %w", err)
+	}
+
+	rules, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
+		return r.data.Client.Tag.GetNotificationRules(ctx, name, po, dtrack.SortOptions{})
+	})
+	if err != nil && !isNotFound(err) {
+		return nil, nil, nil, fmt.Errorf("unable to read tagged notification rules: %w", err)
+	}
+
+	return projects, policies, rules, nil
+}
+
+// describeReferences explains a rejected delete by listing what the tag is
+// still attached to, as far as the caller can see. It returns an empty string
+// when there is nothing to add to the server's error.
+func (r *TagResource) describeReferences(ctx context.Context, name string) string {
+	projects, policies, rules, err := r.tagReferences(ctx, name)
+	if err != nil || len(projects)+len(policies)+len(rules) == 0 {
+		return ""
+	}
+
+	var references []string
+	for _, project := range projects {
+		references = append(references, fmt.Sprintf("project %q (version %q)", project.Name, project.Version))
+	}
+	for _, policy := range policies {
+		references = append(references, fmt.Sprintf("policy %q", policy.Name))
+	}
+	for _, rule := range rules {
+		references = append(references, fmt.Sprintf("notification rule %q", rule.Name))
+	}
+	return fmt.Sprintf("\n\nTag %q is still attached to:\n\n  - %s\n\n"+
+		"Detach it first, or set force_destroy = true and apply that change to detach it along with destroying the tag.",
+		name, strings.Join(references, "\n  - "))
+}
+
+// removeReferences detaches the tag from every project, policy and
+// notification rule it is attached to, for force_destroy.
+func (r *TagResource) removeReferences(ctx context.Context, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	projects, policies, rules, err := r.tagReferences(ctx, name)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read tag references, got error: %s", err))
+		return diags
+	}
+
+	if len(projects) > 0 {
+		uuids := make([]uuid.UUID, 0, len(projects))
+		for _, project := range projects {
+			uuids = append(uuids, project.UUID)
+		}
+		if err := r.data.Client.Tag.UntagProjects(ctx, name, uuids); err != nil && !isNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove tag from projects, got error: %s", err))
+			return diags
+		}
+	}
+	if len(policies) > 0 {
+		uuids := make([]uuid.UUID, 0, len(policies))
+		for _, policy := range policies {
+			uuids = append(uuids, policy.UUID)
+		}
+		if err := r.data.Client.Tag.UntagPolicies(ctx, name, uuids); err != nil && !isNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove tag from policies, got error: %s", err))
+			return diags
+		}
+	}
+	if len(rules) > 0 {
+		uuids := make([]uuid.UUID, 0, len(rules))
+		for _, rule := range rules {
+			uuids = append(uuids, rule.UUID)
+		}
+		if err := r.data.Client.Tag.UntagNotificationRules(ctx, name, uuids); err != nil && !isNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove tag from notification rules, got error: %s", err))
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -2,12 +2,17 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccTagResource(t *testing.T) {
@@ -53,6 +58,154 @@ func TestAccTagResource(t *testing.T) {
 	})
 }
 
+// TestAccTagResource_PolicyAssociation verifies that a managed tag can be
+// used as the handle for a policy association, and that the tag and its
+// association are torn down in the right order.
+func TestAccTagResource_PolicyAssociation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagResourceConfigWithPolicy(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_policy_tag.test",
+						tfjsonpath.New("tag"),
+						knownvalue.StringExact("tf-acc-tag-policy"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTagResource_ForceDestroy(t *testing.T) {
+	testAccSeedPreCheck(t)
+
+	name := "tf-acc-tag-force-" + randomSuffix()
+	projectUUID := testAccSeedProject(t, name, "1.0.0")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The tag was still attached to the project when it was destroyed
+		CheckDestroy: func(*terraform.State) error {
+			return testAccCheckTagDestroyed(t, name, projectUUID)
+		},
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// removed blocks
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagResourceConfigForceDestroy(name, false) + `
+resource "dependencytrack_policy_tag" "test" {
+  tag    = dependencytrack_tag.test.name
+  policy = dependencytrack_policy.test.id
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_tag.test",
+						tfjsonpath.New("force_destroy"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Forget the association without removing it, so the policy
+			// stays tagged like one tagged outside Terraform
+			{
+				Config: testAccTagResourceConfigForceDestroy(name, false) + `
+removed {
+  from = dependencytrack_policy_tag.test
+
+  lifecycle {
+    destroy = false
+  }
+}
+`,
+			},
+			// Without force_destroy the tag is deleted the way
+			// Dependency-Track deletes it, detaching it from the policy
+			{
+				Config:  testAccTagResourceConfigForceDestroy(name, false),
+				Destroy: true,
+			},
+			// With force_destroy the tag is detached along with destroying it
+			{
+				Config: testAccTagResourceConfigForceDestroy(name, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_tag.test",
+						tfjsonpath.New("force_destroy"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+			// Tag the project outside Terraform, so the tag is still attached
+			// when the test case destroys it
+			{
+				PreConfig: func() {
+					if status := testAccAPIDo(t, http.MethodPost, "/api/v1/tag/"+name+"/project", []string{projectUUID}, nil); status < 200 || status >= 300 {
+						t.Fatalf("tagging project %s: unexpected status %d", projectUUID, status)
+					}
+				},
+				Config: testAccTagResourceConfigForceDestroy(name, true),
+				Check: func(*terraform.State) error {
+					if tags := testAccProjectTags(t, projectUUID); !slices.Contains(tags, name) {
+						return fmt.Errorf("project %s has tags %v, want %q among them", projectUUID, tags, name)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+// testAccProjectTags returns the names of the tags attached to a project.
+func testAccProjectTags(t *testing.T, projectUUID string) []string {
+	t.Helper()
+
+	var project struct {
+		Tags []struct {
+			Name string `json:"name"`
+		} `json:"tags"`
+	}
+	if status := testAccAPIDo(t, http.MethodGet, "/api/v1/project/"+projectUUID, nil, &project); status != http.StatusOK {
+		t.Fatalf("reading project %s: unexpected status %d", projectUUID, status)
+	}
+
+	names := make([]string, 0, len(project.Tags))
+	for _, tag := range project.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+// testAccCheckTagDestroyed verifies that the tag no longer exists and is no
+// longer attached to the project.
+func testAccCheckTagDestroyed(t *testing.T, name, projectUUID string) error {
+	t.Helper()
+
+	var tags []struct {
+		Name string `json:"name"`
+	}
+	if status := testAccAPIDo(t, http.MethodGet, "/api/v1/tag?searchText="+url.QueryEscape(name), nil, &tags); status != http.StatusOK {
+		return fmt.Errorf("listing tags: unexpected status %d", status)
+	}
+	for _, tag := range tags {
+		if tag.Name == name {
+			return fmt.Errorf("tag %q still exists", name)
+		}
+	}
+
+	if projectTags := testAccProjectTags(t, projectUUID); slices.Contains(projectTags, name) {
+		return fmt.Errorf("project %s is still tagged %q", projectUUID, name)
+	}
+	return nil
+}
+
 func testAccTagResourceConfig(name string) string {
 	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
 resource "dependencytrack_tag" "test" {
@@ -60,3 +213,37 @@ resource "dependencytrack_tag" "test" {
 }
 `, name)
 }
+
+func testAccTagResourceConfigWithPolicy() string {
+	return testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_tag" "test" {
+  name = "tf-acc-tag-policy"
+}
+
+resource "dependencytrack_policy" "test" {
+  name            = "Tag Association Test Policy"
+  operator        = "ANY"
+  violation_state = "INFO"
+}
+
+resource "dependencytrack_policy_tag" "test" {
+  tag    = dependencytrack_tag.test.name
+  policy = dependencytrack_policy.test.id
+}
+`
+}
+
+func testAccTagResourceConfigForceDestroy(name string, forceDestroy bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_tag" "test" {
+  name          = %q
+  force_destroy = %t
+}
+
+resource "dependencytrack_policy" "test" {
+  name            = "Tag Force Destroy Policy %s"
+  operator        = "ANY"
+  violation_state = "INFO"
+}
+`, name, forceDestroy, name)
+}
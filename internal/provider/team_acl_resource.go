@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamACLResource{}
+var _ resource.ResourceWithImportState = &TeamACLResource{}
+
+func NewTeamACLResource() resource.Resource {
+	return &TeamACLResource{}
+}
+
+// TeamACLResource defines the resource implementation.
+type TeamACLResource struct {
+	data *Data
+}
+
+// TeamACLResourceModel describes the resource data model.
+type TeamACLResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Team     types.String `tfsdk:"team"`
+	Projects types.Set    `tfsdk:"projects"`
+}
+
+func (r *TeamACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_acl"
+}
+
+func (r *TeamACLResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete set of projects a Dependency-Track team has ACL access to. " +
+			"Projects mapped to the team that are not listed are removed, so this resource owns the team's ACL entirely. " +
+			"Do not combine it with `dependencytrack_acl_mapping` resources for the same team: the two will keep undoing each other's changes. " +
+			"ACLs are only enforced when portfolio access control is enabled (see `dependencytrack_acl_config`).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the resource (same as the team UUID)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the team. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"projects": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Set of project UUIDs the team has access to",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(uuidValidator{}),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *TeamACLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamACLResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamUUID, err := uuid.Parse(data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, teamUUID, data.Projects)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(teamUUID.String())
+
+	tflog.Trace(ctx, "created a team ACL resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamACLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamACLResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamUUID, err := uuid.Parse(data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+		return
+	}
+
+	current, err := r.currentProjects(ctx, teamUUID)
+	if err != nil {
+		// The team being gone takes its ACL with it.
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ACL mappings, got error: %s", err))
+		return
+	}
+
+	var prior []string
+	resp.Diagnostics.Append(data.Projects.ElementsAs(ctx, &prior, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, diags := types.SetValueFrom(ctx, types.StringType, uuidsInPriorSpelling(current, prior))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(teamUUID.String())
+	data.Projects = projects
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamACLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamACLResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamUUID, err := uuid.Parse(data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, teamUUID, data.Projects)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(teamUUID.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamACLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamACLResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamUUID, err := uuid.Parse(data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+		return
+	}
+
+	var projects []string
+	resp.Diagnostics.Append(data.Projects.ElementsAs(ctx, &projects, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, project := range projects {
+		projectUUID, err := uuid.Parse(project)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID %q: %s", project, err))
+			return
+		}
+		err = r.data.Client.ACL.RemoveProjectMapping(ctx, teamUUID, projectUUID)
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove project %s from team ACL, got error: %s", project, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted a team ACL resource")
+}
+
+func (r *TeamACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import using the team UUID
+	teamUUID, err := uuid.Parse(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Unable to parse UUID. Expected a valid team UUID, got: %s\nError: %s", req.ID, err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamUUID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), teamUUID.String())...)
+}
+
+// reconcile maps exactly the given projects to the team, adding missing
+// mappings and removing extra ones. The diff is computed against the live ACL
+// so mappings changed outside Terraform are corrected too.
+func (r *TeamACLResource) reconcile(ctx context.Context, teamUUID uuid.UUID, projects types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desired []string
+	diags.Append(projects.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+	desired = canonicalUUIDs(desired)
+
+	current, err := r.currentProjects(ctx, teamUUID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read ACL mappings, got error: %s", err))
+		return diags
+	}
+
+	for _, project := range stringSetDifference(desired, current) {
+		projectUUID, err := uuid.Parse(project)
+		if err != nil {
+			diags.AddAttributeError(path.Root("projects"), "Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID %q: %s", project, err))
+			return diags
+		}
+		err = r.data.Client.ACL.AddProjectMapping(ctx, dtrack.ACLMappingRequest{Team: teamUUID, Project: projectUUID})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to add project %s to team ACL, got error: %s", project, err))
+			return diags
+		}
+	}
+
+	for _, project := range stringSetDifference(current, desired) {
+		projectUUID, err := uuid.Parse(project)
+		if err != nil {
+			diags.AddError("Invalid Project UUID", fmt.Sprintf("Unable to parse project UUID %q: %s", project, err))
+			return diags
+		}
+		err = r.data.Client.ACL.RemoveProjectMapping(ctx, teamUUID, projectUUID)
+		if err != nil && !isNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove project %s from team ACL, got error: %s", project, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// currentProjects returns the UUIDs of every project mapped to the team,
+// paging through the team's full ACL.
+func (r *TeamACLResource) currentProjects(ctx context.Context, teamUUID uuid.UUID) ([]string, error) {
+	projects, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+		return r.data.Client.ACL.GetAllProjects(ctx, teamUUID, po)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]string, 0, len(projects))
+	for _, project := range projects {
+		uuids = append(uuids, project.UUID.String())
+	}
+	return uuids, nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamACLResource(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamACLResourceConfig(suffix, "dependencytrack_project.a.id, dependencytrack_project.b.id"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_acl.test",
+						tfjsonpath.New("projects"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "dependencytrack_team_acl.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update swaps one project for another
+			{
+				Config: testAccTeamACLResourceConfig(suffix, "dependencytrack_project.b.id, dependencytrack_project.c.id"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_acl.test",
+						tfjsonpath.New("projects"),
+						knownvalue.SetSizeExact(2),
+					),
+				},
+			},
+			// Update to an empty ACL
+			{
+				Config: testAccTeamACLResourceConfig(suffix, ""),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_acl.test",
+						tfjsonpath.New("projects"),
+						knownvalue.SetSizeExact(0),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTeamACLResource_RemovesUnmanagedMappings(t *testing.T) {
+	testAccSeedPreCheck(t)
+
+	suffix := randomSuffix()
+	teamUUID := testAccSeedTeam(t, "Test Team ACL Unmanaged "+suffix)
+	extraProjectUUID := testAccSeedProject(t, "Test Team ACL Unmanaged Project "+suffix, "1.0.0")
+
+	config := testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_project" "test" {
+  name    = "Test Team ACL Managed Project %s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_team_acl" "test" {
+  team     = %q
+  projects = [dependencytrack_project.test.id]
+}
+`, suffix, teamUUID)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// A mapping added outside Terraform is removed on the next apply
+			{
+				PreConfig: func() {
+					status := testAccAPIDo(t, http.MethodPut, "/api/v1/acl/mapping", map[string]string{
+						"team":    teamUUID,
+						"project": extraProjectUUID,
+					}, nil)
+					if status < 200 || status >= 300 {
+						t.Fatalf("adding unmanaged ACL mapping: unexpected status %d", status)
+					}
+				},
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValueCollection(
+						"dependencytrack_team_acl.test",
+						[]tfjsonpath.Path{tfjsonpath.New("projects")},
+						"dependencytrack_project.test",
+						tfjsonpath.New("id"),
+						compare.ValuesSame(),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_acl.test",
+						tfjsonpath.New("projects"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeamACLResourceConfig(suffix, projects string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+  name = "Test Team ACL %[1]s"
+}
+
+resource "dependencytrack_project" "a" {
+  name    = "Test Team ACL Project A %[1]s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project" "b" {
+  name    = "Test Team ACL Project B %[1]s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project" "c" {
+  name    = "Test Team ACL Project C %[1]s"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_team_acl" "test" {
+  team     = dependencytrack_team.test.id
+  projects = [%[2]s]
+}
+`, suffix, projects)
+}
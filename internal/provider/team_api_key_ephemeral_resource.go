@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &TeamAPIKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &TeamAPIKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &TeamAPIKeyEphemeralResource{}
+
+// teamAPIKeyPrivateKey is the private data key under which Open records the
+// public ID of the generated key, so that Close knows which key to delete.
+const teamAPIKeyPrivateKey = "public_id"
+
+func NewTeamAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &TeamAPIKeyEphemeralResource{}
+}
+
+// TeamAPIKeyEphemeralResource defines the ephemeral resource implementation.
+type TeamAPIKeyEphemeralResource struct {
+	data *Data
+}
+
+// TeamAPIKeyEphemeralResourceModel describes the ephemeral resource data model.
+type TeamAPIKeyEphemeralResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Team      types.String `tfsdk:"team"`
+	Key       types.String `tfsdk:"key"`
+	Comment   types.String `tfsdk:"comment"`
+	MaskedKey types.String `tfsdk:"masked_key"`
+}
+
+// teamAPIKeyPrivateData is the JSON shape stored in private data.
+type teamAPIKeyPrivateData struct {
+	PublicID string `json:"public_id"`
+}
+
+func (r *TeamAPIKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_api_key"
+}
+
+func (r *TeamAPIKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a short-lived API key for a Dependency-Track team. The key is created when the ephemeral resource is opened and deleted again when it is closed, so it is never persisted in the Terraform state or plan.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The public ID of the API key",
+			},
+			"team": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The UUID of the team the API key is generated for",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key value",
+			},
+			"comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Comment or description for the API key (max 255 characters)",
+			},
+			"masked_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The masked version of the API key",
+			},
+		},
+	}
+}
+
+func (r *TeamAPIKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.data = data
+}
+
+func (r *TeamAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data TeamAPIKeyEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamUUID, err := uuid.Parse(data.Team.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Team UUID", fmt.Sprintf("Unable to parse team UUID: %s", err))
+		return
+	}
+
+	apiKey, err := r.data.Client.Team.GenerateAPIKey(ctx, teamUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate API key, got error: %s", err))
+		return
+	}
+
+	if !data.Comment.IsNull() && data.Comment.ValueString() != "" {
+		_, err = r.data.Client.Team.UpdateAPIKeyComment(ctx, apiKey.PublicId, data.Comment.ValueString())
+		if err != nil {
+			// Close is not called when Open fails, so remove the key here
+			// rather than leaving an orphaned credential behind.
+			if delErr := r.data.Client.Team.DeleteAPIKey(ctx, apiKey.PublicId); delErr != nil {
+				tflog.Warn(ctx, "unable to delete API key after failed comment update", map[string]interface{}{"error": delErr.Error()})
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API key comment, got error: %s", err))
+			return
+		}
+	}
+
+	privateData, err := json.Marshal(teamAPIKeyPrivateData{PublicID: apiKey.PublicId})
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to encode private data, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, teamAPIKeyPrivateKey, privateData)...)
+
+	data.ID = types.StringValue(apiKey.PublicId)
+	data.Key = types.StringValue(apiKey.Key)
+	data.MaskedKey = types.StringValue(apiKey.MaskedKey)
+
+	tflog.Trace(ctx, "opened a team API key ephemeral resource")
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *TeamAPIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	privateData, diags := req.Private.GetKey(ctx, teamAPIKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || privateData == nil {
+		return
+	}
+
+	var private teamAPIKeyPrivateData
+	if err := json.Unmarshal(privateData, &private); err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to decode private data, got error: %s", err))
+		return
+	}
+
+	err := r.data.Client.Team.DeleteAPIKey(ctx, private.PublicID)
+	if err != nil {
+		// The key being gone already is the desired end state.
+		if isNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete API key, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "closed a team API key ephemeral resource")
+}
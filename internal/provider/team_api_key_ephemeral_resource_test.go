@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTeamAPIKeyEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamAPIKeyEphemeralResourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("key"),
+						knownvalue.StringRegexp(regexp.MustCompile(`.+`)),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("id"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"echo.test",
+						tfjsonpath.New("data").AtMapKey("comment"),
+						knownvalue.StringExact("Ephemeral CI key"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeamAPIKeyEphemeralResourceConfig() string {
+	return testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_team" "test" {
+  name = "Test Team Ephemeral API Key"
+}
+
+ephemeral "dependencytrack_team_api_key" "test" {
+  team    = dependencytrack_team.test.id
+  comment = "Ephemeral CI key"
+}
+
+provider "echo" {
+  data = ephemeral.dependencytrack_team_api_key.test
+}
+
+resource "echo" "test" {}
+`
+}
@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 
+	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,6 +19,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &TeamAPIKeyResource{}
 var _ resource.ResourceWithImportState = &TeamAPIKeyResource{}
+var _ resource.ResourceWithModifyPlan = &TeamAPIKeyResource{}
 
 func NewTeamAPIKeyResource() resource.Resource {
 	return &TeamAPIKeyResource{}
@@ -27,11 +32,12 @@ type TeamAPIKeyResource struct {
 
 // TeamAPIKeyResourceModel describes the resource data model.
 type TeamAPIKeyResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	Team      types.String `tfsdk:"team"`
-	Key       types.String `tfsdk:"key"`
-	Comment   types.String `tfsdk:"comment"`
-	MaskedKey types.String `tfsdk:"masked_key"`
+	ID            types.String `tfsdk:"id"`
+	Team          types.String `tfsdk:"team"`
+	Key           types.String `tfsdk:"key"`
+	Comment       types.String `tfsdk:"comment"`
+	MaskedKey     types.String `tfsdk:"masked_key"`
+	RotationToken types.String `tfsdk:"rotation_token"`
 }
 
 func (r *TeamAPIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,7 +46,9 @@ func (r *TeamAPIKeyResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *TeamAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages an API key for a Dependency-Track team. The actual API key value is only available upon creation and cannot be retrieved later.",
+		MarkdownDescription: "Manages an API key for a Dependency-Track team. The actual API key value is only available upon creation and cannot be retrieved later, " +
+			"so it is kept in the Terraform state. Terraform's write-only arguments only carry values into a resource and cannot hold a key generated by the server; " +
+			"when the key must not be persisted, use the `dependencytrack_team_api_key` ephemeral resource instead, which generates a key for the duration of a run and deletes it afterwards.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -60,7 +68,7 @@ func (r *TeamAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"key": schema.StringAttribute{
 				Computed:            true,
 				Sensitive:           true,
-				MarkdownDescription: "The API key value. This is only available upon creation and cannot be retrieved later.",
+				MarkdownDescription: "The API key value. This is only available upon creation and cannot be retrieved later, so it is stored in the Terraform state.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -76,6 +84,12 @@ func (r *TeamAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation_token": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "An arbitrary value that, when changed, regenerates the API key in place. " +
+					"The new key value and its public ID replace the old ones in state, and the comment is preserved. " +
+					"Setting the attribute for the first time, or removing it, does not regenerate the key.",
+			},
 		},
 	}
 }
@@ -98,6 +112,34 @@ func (r *TeamAPIKeyResource) Configure(ctx context.Context, req resource.Configu
 	r.data = data
 }
 
+func (r *TeamAPIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state TeamAPIKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A token that is still unknown may turn out to differ at apply time.
+	mayRotate := !state.RotationToken.IsNull() && plan.RotationToken.IsUnknown()
+	if !mayRotate && !shouldRotateTeamAPIKey(state.RotationToken, plan.RotationToken) {
+		return
+	}
+
+	// Regenerating issues a brand new key (and with it a new public ID), so
+	// the values carried over by UseStateForUnknown are no longer accurate.
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("key"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("masked_key"), types.StringUnknown())...)
+}
+
 func (r *TeamAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TeamAPIKeyResourceModel
 
@@ -152,7 +194,9 @@ func (r *TeamAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	// Get all API keys for the team
+	// Get all API keys for the team. client-go finds them by listing every
+	// team, so a deleted team yields no keys rather than a 404 and is handled
+	// by the not-found path below.
 	apiKeys, err := r.data.Client.Team.GetAPIKeys(ctx, teamUUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team API keys, got error: %s", err))
@@ -195,21 +239,40 @@ func (r *TeamAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// Preserve the state values
+	plan.ID = state.ID
+	plan.Team = state.Team
+	plan.Key = state.Key
+	plan.MaskedKey = state.MaskedKey
+
+	commentChanged := !plan.Comment.Equal(state.Comment)
+
+	if shouldRotateTeamAPIKey(state.RotationToken, plan.RotationToken) {
+		apiKey, err := r.regenerateAPIKey(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to regenerate API key, got error: %s", err))
+			return
+		}
+
+		plan.ID = types.StringValue(apiKey.PublicId)
+		plan.Key = types.StringValue(apiKey.Key)
+		plan.MaskedKey = types.StringValue(apiKey.MaskedKey)
+
+		// Re-apply the comment if the regenerated key didn't carry it over.
+		if apiKey.Comment != plan.Comment.ValueString() {
+			commentChanged = true
+		}
+	}
+
 	// Only the comment can be updated
-	if !plan.Comment.Equal(state.Comment) {
-		_, err := r.data.Client.Team.UpdateAPIKeyComment(ctx, state.ID.ValueString(), plan.Comment.ValueString())
+	if commentChanged {
+		_, err := r.data.Client.Team.UpdateAPIKeyComment(ctx, plan.ID.ValueString(), plan.Comment.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update API key comment, got error: %s", err))
 			return
 		}
 	}
 
-	// Preserve the state values
-	plan.ID = state.ID
-	plan.Team = state.Team
-	plan.Key = state.Key
-	plan.MaskedKey = state.MaskedKey
-
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -236,3 +299,26 @@ func (r *TeamAPIKeyResource) ImportState(ctx context.Context, req resource.Impor
 		"Importing team API keys is not supported because the actual API key value is only available upon creation and cannot be retrieved later. Please recreate the resource instead.",
 	)
 }
+
+// regenerateAPIKey replaces the key identified by publicID with a freshly
+// generated one via POST /api/v1/team/key/{publicIdOrKey}, which client-go
+// does not cover. The response carries the new key value and public ID.
+func (r *TeamAPIKeyResource) regenerateAPIKey(ctx context.Context, publicID string) (dtrack.APIKey, error) {
+	var apiKey dtrack.APIKey
+	if err := r.data.API().Do(ctx, http.MethodPost, "/api/v1/team/key/"+url.PathEscape(publicID), nil, &apiKey); err != nil {
+		return dtrack.APIKey{}, err
+	}
+
+	return apiKey, nil
+}
+
+// shouldRotateTeamAPIKey reports whether a change of rotation_token from
+// prior to planned should regenerate the key. Only a change between two
+// non-null values counts, so that adopting (or dropping) the attribute on an
+// existing key does not invalidate it.
+func shouldRotateTeamAPIKey(prior, planned types.String) bool {
+	if prior.IsNull() || planned.IsNull() || planned.IsUnknown() {
+		return false
+	}
+	return !prior.Equal(planned)
+}
@@ -3,6 +3,8 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -148,3 +150,81 @@ resource "dependencytrack_team_api_key" "test2" {
 }
 `
 }
+
+func TestAccTeamAPIKeyResource_Rotation(t *testing.T) {
+	keyChanges := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with an initial rotation token
+			{
+				Config: testAccTeamAPIKeyResourceConfigRotation("v1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					keyChanges.AddStateValue(
+						"dependencytrack_team_api_key.test",
+						tfjsonpath.New("key"),
+					),
+				},
+			},
+			// Changing the token regenerates the key in place and keeps the comment
+			{
+				Config: testAccTeamAPIKeyResourceConfigRotation("v2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					keyChanges.AddStateValue(
+						"dependencytrack_team_api_key.test",
+						tfjsonpath.New("key"),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_api_key.test",
+						tfjsonpath.New("comment"),
+						knownvalue.StringExact("Rotated API Key"),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_api_key.test",
+						tfjsonpath.New("rotation_token"),
+						knownvalue.StringExact("v2"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeamAPIKeyResourceConfigRotation(rotationToken string) string {
+	return testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_team" "test" {
+  name = "Test Team API Key Rotation"
+}
+
+resource "dependencytrack_team_api_key" "test" {
+  team           = dependencytrack_team.test.id
+  comment        = "Rotated API Key"
+  rotation_token = "` + rotationToken + `"
+}
+`
+}
+
+func TestShouldRotateTeamAPIKey(t *testing.T) {
+	tests := []struct {
+		name           string
+		prior, planned types.String
+		want           bool
+	}{
+		{"unchanged", types.StringValue("a"), types.StringValue("a"), false},
+		{"changed", types.StringValue("a"), types.StringValue("b"), true},
+		{"newly set", types.StringNull(), types.StringValue("a"), false},
+		{"removed", types.StringValue("a"), types.StringNull(), false},
+		{"unknown", types.StringValue("a"), types.StringUnknown(), false},
+		{"never set", types.StringNull(), types.StringNull(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldRotateTeamAPIKey(tt.prior, tt.planned); got != tt.want {
+				t.Errorf("shouldRotateTeamAPIKey(%s, %s) = %v, want %v", tt.prior, tt.planned, got, tt.want)
+			}
+		})
+	}
+}
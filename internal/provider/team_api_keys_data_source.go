@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -35,6 +36,8 @@ type TeamAPIKeyDataModel struct {
 	Comment   types.String `tfsdk:"comment"`
 	MaskedKey types.String `tfsdk:"masked_key"`
 	Legacy    types.Bool   `tfsdk:"legacy"`
+	Created   types.Int64  `tfsdk:"created"`
+	LastUsed  types.Int64  `tfsdk:"last_used"`
 }
 
 func (d *TeamAPIKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -75,6 +78,14 @@ func (d *TeamAPIKeysDataSource) Schema(ctx context.Context, req datasource.Schem
 							Computed:            true,
 							MarkdownDescription: "Whether this is a legacy API key",
 						},
+						"created": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp (epoch milliseconds) when the API key was created",
+						},
+						"last_used": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Timestamp (epoch milliseconds) when the API key was last used, or null if it has never been used",
+						},
 					},
 				},
 			},
@@ -132,8 +143,19 @@ func (d *TeamAPIKeysDataSource) Read(ctx context.Context, req datasource.ReadReq
 			Comment:   types.StringValue(key.Comment),
 			MaskedKey: types.StringValue(key.MaskedKey),
 			Legacy:    types.BoolValue(key.Legacy),
+			Created:   types.Int64Value(int64(key.Created)),
+			LastUsed:  apiKeyLastUsed(key),
 		})
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// apiKeyLastUsed returns when key was last used, or null if the server has no
+// record of it being used.
+func apiKeyLastUsed(key dtrack.APIKey) types.Int64 {
+	if key.LastUsed == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(key.LastUsed))
+}
@@ -28,6 +28,18 @@ func TestAccTeamAPIKeysDataSource(t *testing.T) {
 						tfjsonpath.New("api_keys"),
 						knownvalue.NotNull(),
 					),
+					// Freshly created keys have a creation time but have
+					// not been used yet.
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_team_api_keys.test",
+						tfjsonpath.New("api_keys").AtSliceIndex(0).AtMapKey("created"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_team_api_keys.test",
+						tfjsonpath.New("api_keys").AtSliceIndex(0).AtMapKey("last_used"),
+						knownvalue.Null(),
+					),
 				},
 			},
 		},
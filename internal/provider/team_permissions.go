@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+// getTeamWithPermissions reads a team via get until its permissions include
+// every name in added and none in removed. Dependency-Track caches team
+// lookups, so a read issued right after adding or removing a permission can
+// still return the old set; reading that back into state would make Terraform
+// report an inconsistent result after apply. The read is retried via
+// retryRead, and a clear error is returned if the permissions never converge.
+func getTeamWithPermissions(ctx context.Context, get func(context.Context) (dtrack.Team, error), added, removed []string) (dtrack.Team, error) {
+	return retryRead(ctx, get, func(team dtrack.Team, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		current := make(map[string]bool, len(team.Permissions))
+		for _, perm := range team.Permissions {
+			current[perm.Name] = true
+		}
+
+		var missing, lingering []string
+		for _, name := range added {
+			if !current[name] {
+				missing = append(missing, name)
+			}
+		}
+		for _, name := range removed {
+			if current[name] {
+				lingering = append(lingering, name)
+			}
+		}
+		var problems []string
+		if len(missing) > 0 {
+			problems = append(problems, "missing "+strings.Join(missing, ", "))
+		}
+		if len(lingering) > 0 {
+			problems = append(problems, "still granted "+strings.Join(lingering, ", "))
+		}
+		if len(problems) == 0 {
+			return nil
+		}
+		return fmt.Errorf("team permissions did not reflect the requested changes after %d reads (%s)", readRetryAttempts, strings.Join(problems, "; "))
+	})
+}
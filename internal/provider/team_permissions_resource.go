@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -33,6 +36,7 @@ type TeamPermissionsResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	Team        types.String `tfsdk:"team"`
 	Permissions types.Set    `tfsdk:"permissions"`
+	Exclusive   types.Bool   `tfsdk:"exclusive"`
 }
 
 func (r *TeamPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,7 +45,7 @@ func (r *TeamPermissionsResource) Metadata(ctx context.Context, req resource.Met
 
 func (r *TeamPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages permissions for a Dependency-Track team. This resource manages the complete set of permissions assigned to a team. Available permissions: ACCESS_MANAGEMENT, BOM_UPLOAD, POLICY_MANAGEMENT, POLICY_VIOLATION_ANALYSIS, PORTFOLIO_MANAGEMENT, PROJECT_CREATION_UPLOAD, SYSTEM_CONFIGURATION, TAG_MANAGEMENT, VIEW_BADGES, VIEW_POLICY_VIOLATION, VIEW_PORTFOLIO, VIEW_VULNERABILITY, VULNERABILITY_ANALYSIS, VULNERABILITY_MANAGEMENT.",
+		MarkdownDescription: "Manages permissions for a Dependency-Track team. By default this resource manages the complete set of permissions assigned to a team; see `exclusive`. Teams that already hold permissions, such as the Automation team created by Dependency-Track, are adopted on create without revoking any of them, or can be imported by team UUID. Available permissions: ACCESS_MANAGEMENT, BOM_UPLOAD, POLICY_MANAGEMENT, POLICY_VIOLATION_ANALYSIS, PORTFOLIO_MANAGEMENT, PROJECT_CREATION_UPLOAD, SYSTEM_CONFIGURATION, TAG_MANAGEMENT, VIEW_BADGES, VIEW_POLICY_VIOLATION, VIEW_PORTFOLIO, VIEW_VULNERABILITY, VULNERABILITY_ANALYSIS, VULNERABILITY_MANAGEMENT.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -66,6 +70,15 @@ func (r *TeamPermissionsResource) Schema(ctx context.Context, req resource.Schem
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"exclusive": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Whether `permissions` is the complete set of permissions of the team. When `true`, permissions granted outside of Terraform show up as drift and are revoked on the next apply. " +
+					"Creating the resource never revokes permissions: ones the team already holds that are not in `permissions` are left alone, with a warning, and show up as drift on the next plan. " +
+					"When `false`, only the configured permissions are granted and tracked, and removing one from `permissions` or destroying the resource only revokes permissions that were in `permissions`; others are left alone. " +
+					"Switching from `true` to `false` revokes nothing. This is a Terraform-only setting that is not stored in Dependency-Track. Defaults to `true`.",
+			},
 		},
 	}
 }
@@ -110,8 +123,23 @@ func (r *TeamPermissionsResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Add each permission to the team
-	for _, permName := range desiredPermissions {
+	// The team may already hold permissions, e.g. teams created by
+	// Dependency-Track's bootstrap such as Automation. Dependency-Track
+	// rejects granting a permission twice, so only the missing ones are
+	// added. Permissions that are not configured are left alone; for an
+	// exclusive resource they show up as drift on the next plan.
+	existingTeam, err := r.data.Client.Team.Get(ctx, teamUUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		return
+	}
+	existingPermissions := make([]string, 0, len(existingTeam.Permissions))
+	for _, perm := range existingTeam.Permissions {
+		existingPermissions = append(existingPermissions, perm.Name)
+	}
+
+	// Add each missing permission to the team
+	for _, permName := range stringSetDifference(desiredPermissions, existingPermissions) {
 		permission := dtrack.Permission{
 			Name: permName,
 		}
@@ -122,8 +150,22 @@ func (r *TeamPermissionsResource) Create(ctx context.Context, req resource.Creat
 		}
 	}
 
-	// Read back the team to get actual permissions from the API
-	team, err := r.data.Client.Team.Get(ctx, teamUUID)
+	if unconfigured := stringSetDifference(existingPermissions, desiredPermissions); len(unconfigured) > 0 && data.Exclusive.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("permissions"),
+			"Team Holds Unconfigured Permissions",
+			fmt.Sprintf("The team already holds permissions that are not in permissions: %s. They were left alone, "+
+				"but as exclusive is true the next plan shows them as drift and applying it revokes them. "+
+				"Add them to permissions, set exclusive = false, or import the team's permissions with terraform import instead.",
+				strings.Join(unconfigured, ", ")),
+		)
+	}
+
+	// Read back the team to get actual permissions from the API, waiting for
+	// the additions to become visible.
+	team, err := getTeamWithPermissions(ctx, func(ctx context.Context) (dtrack.Team, error) {
+		return r.data.Client.Team.Get(ctx, teamUUID)
+	}, desiredPermissions, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team after create, got error: %s", err))
 		return
@@ -135,8 +177,9 @@ func (r *TeamPermissionsResource) Create(ctx context.Context, req resource.Creat
 		actualPermissions = append(actualPermissions, perm.Name)
 	}
 
-	// Convert to Set type
-	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, actualPermissions)
+	// Only the configured permissions are stored, as planned; Read picks up
+	// the others.
+	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, managedPermissions(actualPermissions, desiredPermissions, false))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -165,6 +208,10 @@ func (r *TeamPermissionsResource) Read(ctx context.Context, req resource.ReadReq
 
 	team, err := r.data.Client.Team.Get(ctx, teamUUID)
 	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
 	}
@@ -181,8 +228,20 @@ func (r *TeamPermissionsResource) Read(ctx context.Context, req resource.ReadReq
 		currentPermissions = append(currentPermissions, perm.Name)
 	}
 
+	// Imported resources and state from before exclusive existed have it
+	// null.
+	if data.Exclusive.IsNull() {
+		data.Exclusive = types.BoolValue(true)
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert to Set type
-	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, currentPermissions)
+	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, managedPermissions(currentPermissions, managed, data.Exclusive.ValueBool()))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -242,7 +301,13 @@ func (r *TeamPermissionsResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
-	// Remove permissions that are in current but not in desired
+	// Remove permissions that are in current but not in desired. When
+	// switching to non-exclusive, state still holds every permission of the
+	// team, including those never granted by Terraform, so nothing is
+	// revoked.
+	if switchingToNonExclusive(state.Exclusive, plan.Exclusive) {
+		currentPermissions = nil
+	}
 	for _, permName := range currentPermissions {
 		if !desiredMap[permName] {
 			permission := dtrack.Permission{
@@ -256,8 +321,11 @@ func (r *TeamPermissionsResource) Update(ctx context.Context, req resource.Updat
 		}
 	}
 
-	// Read back the team to get actual permissions from the API
-	team, err := r.data.Client.Team.Get(ctx, teamUUID)
+	// Read back the team to get actual permissions from the API, waiting for
+	// the additions and removals to become visible.
+	team, err := getTeamWithPermissions(ctx, func(ctx context.Context) (dtrack.Team, error) {
+		return r.data.Client.Team.Get(ctx, teamUUID)
+	}, desiredPermissions, stringSetDifference(currentPermissions, desiredPermissions))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team after update, got error: %s", err))
 		return
@@ -270,7 +338,7 @@ func (r *TeamPermissionsResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	// Convert to Set type
-	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, actualPermissions)
+	permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, managedPermissions(actualPermissions, desiredPermissions, plan.Exclusive.ValueBool()))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -331,3 +399,27 @@ func (r *TeamPermissionsResource) ImportState(ctx context.Context, req resource.
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamUUID.String())...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), teamUUID.String())...)
 }
+
+// managedPermissions returns the permissions to keep in state out of the
+// actual ones: all of them for exclusive resources, otherwise only those also
+// in managed, so that permissions granted outside of Terraform are neither
+// shown as drift nor revoked.
+func managedPermissions(actual, managed []string, exclusive bool) []string {
+	if exclusive {
+		return actual
+	}
+
+	result := make([]string, 0, len(managed))
+	for _, p := range actual {
+		if slices.Contains(managed, p) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// switchingToNonExclusive reports whether exclusive changes from true
+// (including null, the default) to false.
+func switchingToNonExclusive(state, plan types.Bool) bool {
+	return (state.IsNull() || state.ValueBool()) && !plan.ValueBool()
+}
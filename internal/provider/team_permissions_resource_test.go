@@ -1,10 +1,13 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -95,6 +98,144 @@ resource "dependencytrack_team_permissions" "test" {
 	return config
 }
 
+func TestAccTeamPermissionsResource_AdoptExisting(t *testing.T) {
+	testAccSeedPreCheck(t)
+
+	teamUUID := testAccSeedTeam(t, "tf-acc-team-permissions-adopt-"+randomSuffix())
+	for _, permission := range []string{"BOM_UPLOAD", "VIEW_PORTFOLIO"} {
+		if status := testAccAPIDo(t, http.MethodPost, "/api/v1/permission/"+permission+"/team/"+teamUUID, nil, nil); status < 200 || status >= 300 {
+			t.Fatalf("granting %s to seed team: unexpected status %d", permission, status)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create grants the missing permission and leaves the one that is
+			// not configured alone, which the next plan then revokes.
+			{
+				Config: testAccTeamPermissionsResourceConfigAdopt(teamUUID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_team_permissions.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTeamPermissionsResourceConfigAdopt(teamUUID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_permissions.test",
+						tfjsonpath.New("permissions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("BOM_UPLOAD"),
+							knownvalue.StringExact("VIEW_VULNERABILITY"),
+						}),
+					),
+				},
+			},
+			{
+				ResourceName:      "dependencytrack_team_permissions.test",
+				ImportState:       true,
+				ImportStateId:     teamUUID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTeamPermissionsResourceConfigAdopt(teamUUID string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team_permissions" "test" {
+  team        = %q
+  permissions = ["BOM_UPLOAD", "VIEW_VULNERABILITY"]
+}
+`, teamUUID)
+}
+
+func TestAccTeamPermissionsResource_NonExclusive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Two non-exclusive resources on one team don't see each other's
+			// permissions as drift.
+			{
+				Config: testAccTeamPermissionsResourceConfigNonExclusive([]string{"VIEW_PORTFOLIO"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_permissions.test",
+						tfjsonpath.New("permissions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("BOM_UPLOAD"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_permissions.other",
+						tfjsonpath.New("permissions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("VIEW_PORTFOLIO"),
+						}),
+					),
+				},
+			},
+			// Revoking a permission from one leaves the other's alone.
+			{
+				Config: testAccTeamPermissionsResourceConfigNonExclusive([]string{"VIEW_PORTFOLIO", "VIEW_VULNERABILITY"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_permissions.test",
+						tfjsonpath.New("permissions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("BOM_UPLOAD"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team_permissions.other",
+						tfjsonpath.New("permissions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("VIEW_PORTFOLIO"),
+							knownvalue.StringExact("VIEW_VULNERABILITY"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeamPermissionsResourceConfigNonExclusive(otherPermissions []string) string {
+	config := testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_team" "test" {
+  name = "Non-Exclusive Permissions Test Team"
+}
+
+resource "dependencytrack_team_permissions" "test" {
+  team        = dependencytrack_team.test.id
+  permissions = ["BOM_UPLOAD"]
+  exclusive   = false
+}
+
+resource "dependencytrack_team_permissions" "other" {
+  team        = dependencytrack_team.test.id
+  exclusive   = false
+  permissions = [`
+
+	for i, perm := range otherPermissions {
+		if i > 0 {
+			config += ", "
+		}
+		config += `"` + perm + `"`
+	}
+
+	config += `]
+}
+`
+	return config
+}
+
 func TestAccTeamPermissionsResource_MultiplePermissions(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
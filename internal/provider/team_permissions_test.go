@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+)
+
+func teamWithPermissions(names ...string) dtrack.Team {
+	team := dtrack.Team{}
+	for _, name := range names {
+		team.Permissions = append(team.Permissions, dtrack.Permission{Name: name})
+	}
+	return team
+}
+
+func TestGetTeamWithPermissionsImmediate(t *testing.T) {
+	fastReadRetries(t)
+
+	calls := 0
+	team, err := getTeamWithPermissions(context.Background(), func(context.Context) (dtrack.Team, error) {
+		calls++
+		return teamWithPermissions("BOM_UPLOAD", "VIEW_PORTFOLIO"), nil
+	}, []string{"BOM_UPLOAD"}, []string{"SYSTEM_CONFIGURATION"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 1 {
+		t.Fatalf("got %d reads, want 1", calls)
+	}
+	if len(team.Permissions) != 2 {
+		t.Fatalf("got %d permissions, want 2", len(team.Permissions))
+	}
+}
+
+func TestGetTeamWithPermissionsEventuallyConsistent(t *testing.T) {
+	fastReadRetries(t)
+
+	calls := 0
+	_, err := getTeamWithPermissions(context.Background(), func(context.Context) (dtrack.Team, error) {
+		calls++
+		if calls < 3 {
+			return teamWithPermissions("VIEW_PORTFOLIO"), nil
+		}
+		return teamWithPermissions("BOM_UPLOAD"), nil
+	}, []string{"BOM_UPLOAD"}, []string{"VIEW_PORTFOLIO"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 {
+		t.Fatalf("got %d reads, want 3", calls)
+	}
+}
+
+func TestGetTeamWithPermissionsNeverConverges(t *testing.T) {
+	fastReadRetries(t)
+
+	calls := 0
+	_, err := getTeamWithPermissions(context.Background(), func(context.Context) (dtrack.Team, error) {
+		calls++
+		return teamWithPermissions("VIEW_PORTFOLIO"), nil
+	}, []string{"BOM_UPLOAD"}, []string{"VIEW_PORTFOLIO"})
+	if err == nil {
+		t.Fatal("expected an error when permissions never converge")
+	}
+	if calls != readRetryAttempts {
+		t.Fatalf("got %d reads, want %d", calls, readRetryAttempts)
+	}
+	for _, want := range []string{"missing BOM_UPLOAD", "still granted VIEW_PORTFOLIO"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q should mention %q", err, want)
+		}
+	}
+}
+
+func TestGetTeamWithPermissionsReadError(t *testing.T) {
+	fastReadRetries(t)
+
+	wantErr := errors.New("boom")
+	calls := 0
+	_, err := getTeamWithPermissions(context.Background(), func(context.Context) (dtrack.Team, error) {
+		calls++
+		return dtrack.Team{}, wantErr
+	}, []string{"BOM_UPLOAD"}, nil)
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("got error %v, want %v", err, wantErr)
+	}
+	if calls != 1 {
+		t.Fatalf("read errors must not be retried, got %d reads", calls)
+	}
+}
+
+func TestManagedPermissions(t *testing.T) {
+	tests := []struct {
+		name      string
+		actual    []string
+		managed   []string
+		exclusive bool
+		want      []string
+	}{
+		{
+			name:      "exclusive keeps all",
+			actual:    []string{"BOM_UPLOAD", "VIEW_PORTFOLIO"},
+			managed:   []string{"BOM_UPLOAD"},
+			exclusive: true,
+			want:      []string{"BOM_UPLOAD", "VIEW_PORTFOLIO"},
+		},
+		{
+			name:    "non-exclusive keeps managed",
+			actual:  []string{"BOM_UPLOAD", "VIEW_PORTFOLIO"},
+			managed: []string{"BOM_UPLOAD", "SYSTEM_CONFIGURATION"},
+			want:    []string{"BOM_UPLOAD"},
+		},
+		{
+			name:    "non-exclusive nothing managed",
+			actual:  []string{"BOM_UPLOAD"},
+			managed: nil,
+			want:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := managedPermissions(tt.actual, tt.managed, tt.exclusive)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,13 +3,17 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -30,8 +34,12 @@ type TeamResource struct {
 
 // TeamResourceModel describes the resource data model.
 type TeamResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Permissions  types.Set    `tfsdk:"permissions"`
+	OIDCGroups   types.Set    `tfsdk:"oidc_groups"`
+	LDAPDNs      types.Set    `tfsdk:"ldap_dns"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
 }
 
 func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,7 +48,13 @@ func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataReques
 
 func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a team in Dependency-Track",
+		MarkdownDescription: "Manages a team in Dependency-Track. " +
+			"Permissions and OIDC/LDAP group mappings can be set inline with `permissions`, `oidc_groups` and `ldap_dns`, so a team can be described in a single block. " +
+			"Each of these is only managed when it is set in the configuration; leave it unset to manage the same settings with the standalone " +
+			"`dependencytrack_team_permissions`, `dependencytrack_oidc_group_mapping` and `dependencytrack_ldap_mapping` resources instead. " +
+			"Do not combine the inline attribute and the standalone resources for the same setting, as they will fight over it. " +
+			"Removing an inline attribute from the configuration stops managing it but leaves the current values in place. " +
+			"API keys and ACLs are managed with `dependencytrack_team_api_key` and `dependencytrack_acl_mapping`.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -54,6 +68,45 @@ func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "The name of the team",
 				Required:            true,
 			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Set of permission names granted to the team (e.g., `BOM_UPLOAD`, `VIEW_PORTFOLIO`). " +
+					"When set, the team's permissions are reconciled to exactly this set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oidc_groups": schema.SetAttribute{
+				MarkdownDescription: "Set of OIDC group UUIDs mapped to the team. " +
+					"When set, the team's OIDC group mappings are reconciled to exactly this set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ldap_dns": schema.SetAttribute{
+				MarkdownDescription: "Set of LDAP group distinguished names mapped to the team. " +
+					"When set, the team's LDAP mappings are reconciled to exactly this set. " +
+					"When unset, LDAP mappings are neither read nor changed. They are not imported either: the first apply after an import adopts the configured set.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_destroy": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "When `true`, destroying the team first deletes its API keys and removes its ACL mappings. " +
+					"Otherwise destroying a team that still has API keys or ACL mappings fails and lists them. " +
+					"This is a Terraform-only setting that is not stored in Dependency-Track. Defaults to `false`.",
+			},
 		},
 	}
 }
@@ -79,10 +132,11 @@ func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequ
 }
 
 func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data TeamResourceModel
+	var data, config TeamResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -102,6 +156,25 @@ func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Save the ID into state
 	data.ID = types.StringValue(createdTeam.UUID.String())
 
+	resp.Diagnostics.Append(r.reconcileMemberships(ctx, createdTeam.UUID, data, config)...)
+	if resp.Diagnostics.HasError() {
+		// The team exists at this point; record it in state (the framework
+		// marks it tainted) rather than leaving an orphaned team behind.
+		resp.Diagnostics.Append(r.readMemberships(ctx, createdTeam.UUID, &data, nil, nil)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	var added []string
+	if !config.Permissions.IsNull() {
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &added, false)...)
+	}
+
+	resp.Diagnostics.Append(r.readMemberships(ctx, createdTeam.UUID, &data, added, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "created a team resource")
 
@@ -129,6 +202,10 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// Read team from DependencyTrack
 	team, err := r.data.Client.Team.Get(ctx, teamUUID)
 	if err != nil {
+		if isNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
 		return
 	}
@@ -137,15 +214,28 @@ func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Name = types.StringValue(team.Name)
 	data.ID = types.StringValue(team.UUID.String())
 
+	resp.Diagnostics.Append(r.setMemberships(ctx, team, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// force_destroy only lives in state; right after import it is unset and
+	// resolves to its default.
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data TeamResourceModel
+	var data, config, state TeamResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -173,6 +263,24 @@ func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Update state with values from API
 	data.Name = types.StringValue(updatedTeam.Name)
 
+	resp.Diagnostics.Append(r.reconcileMemberships(ctx, teamUUID, data, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var added, removed []string
+	if !config.Permissions.IsNull() {
+		var prior []string
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &added, false)...)
+		resp.Diagnostics.Append(state.Permissions.ElementsAs(ctx, &prior, false)...)
+		removed = stringSetDifference(prior, added)
+	}
+
+	resp.Diagnostics.Append(r.readMemberships(ctx, teamUUID, &data, added, removed)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -194,6 +302,11 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(r.removeDependents(ctx, teamUUID, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete team using DependencyTrack client
 	team := dtrack.Team{
 		UUID: teamUUID,
@@ -212,3 +325,259 @@ func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// removeDependents checks the team for API keys and ACL mappings before it is
+// deleted. With force_destroy they are removed; otherwise an error listing
+// them is returned, so destroying a team never silently revokes access that
+// Terraform does not manage.
+func (r *TeamResource) removeDependents(ctx context.Context, teamUUID uuid.UUID, data TeamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiKeys, err := r.data.Client.Team.GetAPIKeys(ctx, teamUUID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read team API keys, got error: %s", err))
+		return diags
+	}
+
+	projects, err := fetchAllPages(ctx, func(ctx context.Context, po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+		return r.data.Client.ACL.GetAllProjects(ctx, teamUUID, po)
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read ACL mappings, got error: %s", err))
+		return diags
+	}
+
+	if len(apiKeys) == 0 && len(projects) == 0 {
+		return diags
+	}
+
+	if !data.ForceDestroy.ValueBool() {
+		var dependents []string
+		for _, key := range apiKeys {
+			dependents = append(dependents, fmt.Sprintf("API key %s", key.MaskedKey))
+		}
+		for _, project := range projects {
+			dependents = append(dependents, fmt.Sprintf("ACL mapping to project %q (version %q)", project.Name, project.Version))
+		}
+		diags.AddError(
+			"Team Has Dependents",
+			fmt.Sprintf("Team %q cannot be destroyed while it has:\n\n  - %s\n\n"+
+				"Destroy the resources managing them first, or set force_destroy = true and apply that change to remove them along with the team.",
+				data.Name.ValueString(), strings.Join(dependents, "\n  - ")),
+		)
+		return diags
+	}
+
+	for _, key := range apiKeys {
+		// Keys created before Dependency-Track 4.13 have no public ID and are
+		// addressed by the key itself.
+		id := key.PublicId
+		if id == "" {
+			id = key.Key
+		}
+		if err := r.data.Client.Team.DeleteAPIKey(ctx, id); err != nil && !isNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete API key %s, got error: %s", key.MaskedKey, err))
+			return diags
+		}
+	}
+	for _, project := range projects {
+		if err := r.data.Client.ACL.RemoveProjectMapping(ctx, teamUUID, project.UUID); err != nil && !isNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to remove ACL mapping to project %s, got error: %s", project.UUID, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// reconcileMemberships brings the team's permissions, OIDC group mappings and
+// LDAP mappings in line with the plan. Only attributes that are set in the
+// configuration are managed, so that the standalone resources can own them
+// otherwise. Differences are computed against the live team rather than the
+// prior state so changes made outside Terraform are corrected too.
+func (r *TeamResource) reconcileMemberships(ctx context.Context, teamUUID uuid.UUID, plan, config TeamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	team, err := r.data.Client.Team.Get(ctx, teamUUID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		return diags
+	}
+
+	if !config.Permissions.IsNull() {
+		var desired []string
+		diags.Append(plan.Permissions.ElementsAs(ctx, &desired, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		current := make([]string, 0, len(team.Permissions))
+		for _, perm := range team.Permissions {
+			current = append(current, perm.Name)
+		}
+
+		for _, name := range stringSetDifference(desired, current) {
+			if _, err := r.data.Client.Permission.AddPermissionToTeam(ctx, dtrack.Permission{Name: name}, teamUUID); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to add permission %s to team, got error: %s", name, err))
+				return diags
+			}
+		}
+		for _, name := range stringSetDifference(current, desired) {
+			if _, err := r.data.Client.Permission.RemovePermissionFromTeam(ctx, dtrack.Permission{Name: name}, teamUUID); err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to remove permission %s from team, got error: %s", name, err))
+				return diags
+			}
+		}
+	}
+
+	if !config.OIDCGroups.IsNull() {
+		var desired []string
+		diags.Append(plan.OIDCGroups.ElementsAs(ctx, &desired, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		current := make([]string, 0, len(team.MappedOIDCGroups))
+		groupUUIDs := make(map[string]uuid.UUID, len(team.MappedOIDCGroups))
+		for _, mapping := range team.MappedOIDCGroups {
+			current = append(current, mapping.Group.UUID.String())
+			groupUUIDs[mapping.Group.UUID.String()] = mapping.Group.UUID
+		}
+
+		for _, group := range stringSetDifference(desired, current) {
+			groupUUID, err := uuid.Parse(group)
+			if err != nil {
+				diags.AddAttributeError(path.Root("oidc_groups"), "Invalid Group UUID", fmt.Sprintf("Unable to parse OIDC group UUID %q: %s", group, err))
+				return diags
+			}
+			_, err = r.data.Client.OIDC.AddTeamMapping(ctx, dtrack.OIDCMappingRequest{Group: groupUUID, Team: teamUUID})
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to map OIDC group %s to team, got error: %s", group, err))
+				return diags
+			}
+		}
+		for _, group := range stringSetDifference(current, desired) {
+			if err := r.data.Client.OIDC.RemoveTeamMapping2(ctx, groupUUIDs[group], teamUUID); err != nil && !isNotFound(err) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to unmap OIDC group %s from team, got error: %s", group, err))
+				return diags
+			}
+		}
+	}
+
+	if !config.LDAPDNs.IsNull() {
+		var desired []string
+		diags.Append(plan.LDAPDNs.ElementsAs(ctx, &desired, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		mappings, err := r.data.Client.LDAP.GetTeamMappings(ctx, teamUUID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read LDAP mappings, got error: %s", err))
+			return diags
+		}
+
+		current := make([]string, 0, len(mappings))
+		mappingUUIDs := make(map[string]uuid.UUID, len(mappings))
+		for _, mapping := range mappings {
+			current = append(current, mapping.DistinguishedName)
+			mappingUUIDs[mapping.DistinguishedName] = mapping.UUID
+		}
+
+		for _, dn := range stringSetDifference(desired, current) {
+			_, err := r.data.Client.LDAP.AddMapping(ctx, dtrack.MappedLdapGroupRequest{Team: teamUUID, DistinguishedName: dn})
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to map LDAP group %s to team, got error: %s", dn, err))
+				return diags
+			}
+		}
+		for _, dn := range stringSetDifference(current, desired) {
+			if err := r.data.Client.LDAP.RemoveMapping(ctx, mappingUUIDs[dn]); err != nil && !isNotFound(err) {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to unmap LDAP group %s from team, got error: %s", dn, err))
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
+
+// readMemberships refreshes the permissions, oidc_groups and ldap_dns
+// attributes of data from the API, waiting for the added and removed
+// permissions to become visible first.
+func (r *TeamResource) readMemberships(ctx context.Context, teamUUID uuid.UUID, data *TeamResourceModel, added, removed []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	team, err := getTeamWithPermissions(ctx, func(ctx context.Context) (dtrack.Team, error) {
+		return r.data.Client.Team.Get(ctx, teamUUID)
+	}, added, removed)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read team, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(r.setMemberships(ctx, team, data)...)
+	return diags
+}
+
+// setMemberships sets the permissions, oidc_groups and ldap_dns attributes of
+// data from team. LDAP mappings are not part of the team payload and cost a
+// request of their own, which fails on servers without LDAP, so they are only
+// fetched when ldap_dns is tracked; otherwise ldap_dns stays null.
+func (r *TeamResource) setMemberships(ctx context.Context, team dtrack.Team, data *TeamResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	permissions := make([]string, 0, len(team.Permissions))
+	for _, perm := range team.Permissions {
+		permissions = append(permissions, perm.Name)
+	}
+
+	oidcGroups := make([]string, 0, len(team.MappedOIDCGroups))
+	for _, mapping := range team.MappedOIDCGroups {
+		oidcGroups = append(oidcGroups, mapping.Group.UUID.String())
+	}
+
+	var d diag.Diagnostics
+	data.Permissions, d = types.SetValueFrom(ctx, types.StringType, permissions)
+	diags.Append(d...)
+	data.OIDCGroups, d = types.SetValueFrom(ctx, types.StringType, oidcGroups)
+	diags.Append(d...)
+
+	if data.LDAPDNs.IsNull() || data.LDAPDNs.IsUnknown() {
+		data.LDAPDNs = types.SetNull(types.StringType)
+		return diags
+	}
+
+	mappings, err := r.data.Client.LDAP.GetTeamMappings(ctx, team.UUID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read LDAP mappings, got error: %s", err))
+		return diags
+	}
+
+	ldapDNs := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		ldapDNs = append(ldapDNs, mapping.DistinguishedName)
+	}
+
+	data.LDAPDNs, d = types.SetValueFrom(ctx, types.StringType, ldapDNs)
+	diags.Append(d...)
+
+	return diags
+}
+
+// stringSetDifference returns the elements of a that are not in b, in the
+// order they appear in a.
+func stringSetDifference(a, b []string) []string {
+	exclude := make(map[string]struct{}, len(b))
+	for _, s := range b {
+		exclude[s] = struct{}{}
+	}
+
+	var out []string
+	for _, s := range a {
+		if _, ok := exclude[s]; !ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -2,12 +2,16 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccTeamResource(t *testing.T) {
@@ -53,6 +57,34 @@ func TestAccTeamResource(t *testing.T) {
 	})
 }
 
+func TestAccTeamResource_DeletedOutOfBand(t *testing.T) {
+	var teamUUID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceConfig("Out Of Band Team " + randomSuffix()),
+				Check: resource.TestCheckResourceAttrWith("dependencytrack_team.test", "id", func(value string) error {
+					teamUUID = value
+					return nil
+				}),
+			},
+			// A team deleted outside of Terraform is removed from state on
+			// refresh and planned for re-creation instead of failing the read.
+			{
+				PreConfig: func() {
+					if status := testAccAPIDo(t, http.MethodDelete, "/api/v1/team", map[string]string{"uuid": teamUUID}, nil); status < 200 || status >= 300 {
+						t.Fatalf("deleting team %s out of band: unexpected status %d", teamUUID, status)
+					}
+				},
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccTeamResourceConfig(name string) string {
 	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
 resource "dependencytrack_team" "test" {
@@ -60,3 +92,201 @@ resource "dependencytrack_team" "test" {
 }
 `, name)
 }
+
+func TestAccTeamResource_InlineMemberships(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with permissions and mappings in a single block
+			{
+				Config: testAccTeamResourceConfigInline(suffix, []string{"BOM_UPLOAD", "VIEW_PORTFOLIO"}, "cn=developers,ou=groups,dc=example,dc=com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team.test",
+						tfjsonpath.New("permissions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("BOM_UPLOAD"),
+							knownvalue.StringExact("VIEW_PORTFOLIO"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team.test",
+						tfjsonpath.New("oidc_groups"),
+						knownvalue.SetSizeExact(1),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team.test",
+						tfjsonpath.New("ldap_dns"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("cn=developers,ou=groups,dc=example,dc=com"),
+						}),
+					),
+				},
+			},
+			// ImportState testing; LDAP mappings are only read once
+			// ldap_dns is tracked, so they are not imported.
+			{
+				ResourceName:            "dependencytrack_team.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ldap_dns"},
+			},
+			// Update replaces permissions and the LDAP mapping in place
+			{
+				Config: testAccTeamResourceConfigInline(suffix, []string{"VIEW_PORTFOLIO", "VIEW_VULNERABILITY"}, "cn=security,ou=groups,dc=example,dc=com"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team.test",
+						tfjsonpath.New("permissions"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("VIEW_PORTFOLIO"),
+							knownvalue.StringExact("VIEW_VULNERABILITY"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team.test",
+						tfjsonpath.New("ldap_dns"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("cn=security,ou=groups,dc=example,dc=com"),
+						}),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTeamResource_StandalonePermissions(t *testing.T) {
+	suffix := randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Without inline permissions the team must not fight the
+			// standalone resource: a second plan is empty.
+			{
+				Config: testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+  name = "Standalone Permissions Team %s"
+}
+
+resource "dependencytrack_team_permissions" "test" {
+  team        = dependencytrack_team.test.id
+  permissions = ["BOM_UPLOAD"]
+}
+`, suffix),
+			},
+		},
+	})
+}
+
+func testAccTeamResourceConfigInline(suffix string, permissions []string, dn string) string {
+	perms := ""
+	for _, p := range permissions {
+		perms += fmt.Sprintf("%q, ", p)
+	}
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_oidc_group" "test" {
+  name = "tf-acc-inline-team-group-%[1]s"
+}
+
+resource "dependencytrack_team" "test" {
+  name        = "Inline Memberships Team %[1]s"
+  permissions = [%[2]s]
+  oidc_groups = [dependencytrack_oidc_group.test.id]
+  ldap_dns    = [%[3]q]
+}
+`, suffix, perms, dn)
+}
+
+func TestAccTeamResource_ForceDestroy(t *testing.T) {
+	name := "Test Force Destroy Team " + randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// removed blocks
+			tfversion.SkipBelow(tfversion.Version1_7_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamResourceConfigForceDestroy(name, false) + `
+resource "dependencytrack_team_api_key" "test" {
+  team = dependencytrack_team.test.id
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team.test",
+						tfjsonpath.New("force_destroy"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Forget the API key without deleting it, so it outlives its
+			// resource like a key generated outside Terraform
+			{
+				Config: testAccTeamResourceConfigForceDestroy(name, false) + `
+removed {
+  from = dependencytrack_team_api_key.test
+
+  lifecycle {
+    destroy = false
+  }
+}
+`,
+			},
+			// Destroying a team that still has an API key fails
+			{
+				Config:      testAccTeamResourceConfigForceDestroy(name, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile(`cannot be destroyed while it has`),
+			},
+			// With force_destroy the key is deleted along with the team
+			{
+				Config: testAccTeamResourceConfigForceDestroy(name, true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_team.test",
+						tfjsonpath.New("force_destroy"),
+						knownvalue.Bool(true),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeamResourceConfigForceDestroy(name string, forceDestroy bool) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+  name          = %q
+  force_destroy = %t
+}
+`, name, forceDestroy)
+}
+
+func TestStringSetDifference(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []string
+		want []string
+	}{
+		{name: "disjoint", a: []string{"x", "y"}, b: []string{"z"}, want: []string{"x", "y"}},
+		{name: "overlap", a: []string{"x", "y", "z"}, b: []string{"y"}, want: []string{"x", "z"}},
+		{name: "subset", a: []string{"x"}, b: []string{"x", "y"}, want: nil},
+		{name: "empty a", a: nil, b: []string{"x"}, want: nil},
+		{name: "empty b", a: []string{"x"}, b: nil, want: []string{"x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stringSetDifference(tt.a, tt.b); !slices.Equal(got, tt.want) {
+				t.Errorf("stringSetDifference(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamsDataSource{}
+
+func NewTeamsDataSource() datasource.DataSource {
+	return &TeamsDataSource{}
+}
+
+// TeamsDataSource defines the data source implementation.
+type TeamsDataSource struct {
+	data *Data
+}
+
+// TeamsDataSourceModel describes the data source data model.
+type TeamsDataSourceModel struct {
+	ID    types.String    `tfsdk:"id"`
+	Teams []TeamDataModel `tfsdk:"teams"`
+}
+
+// TeamDataModel describes an individual team.
+type TeamDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves all teams of Dependency-Track, e.g. to reconcile or iterate over them in bulk.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this data source result (always `teams`).",
+			},
+			"teams": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of teams",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The UUID of the team",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the team",
+						},
+						"permissions": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Names of the permissions granted to the team",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*Data)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Data, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.data = data
+}
+
+func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, err := fetchAllPages(ctx, d.data.Client.Team.GetAll)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read teams, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue("teams")
+	data.Teams = make([]TeamDataModel, 0, len(teams))
+	for _, team := range teams {
+		permissions := make([]string, 0, len(team.Permissions))
+		for _, perm := range team.Permissions {
+			permissions = append(permissions, perm.Name)
+		}
+
+		permissionsSet, diags := types.SetValueFrom(ctx, types.StringType, permissions)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Teams = append(data.Teams, TeamDataModel{
+			ID:          types.StringValue(team.UUID.String()),
+			Name:        types.StringValue(team.Name),
+			Permissions: permissionsSet,
+		})
+	}
+
+	tflog.Trace(ctx, "read a teams data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamsDataSource(t *testing.T) {
+	name := "tf-acc-teams-ds-" + randomSuffix()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsDataSourceConfig(name),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.dependencytrack_teams.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("teams"),
+					),
+					statecheck.ExpectKnownOutputValue("test_team", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"id":          knownvalue.NotNull(),
+						"name":        knownvalue.StringExact(name),
+						"permissions": knownvalue.SetExact([]knownvalue.Check{knownvalue.StringExact("VIEW_PORTFOLIO")}),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccTeamsDataSourceConfig(name string) string {
+	return testAccProviderConfigWithAPIKey() + fmt.Sprintf(`
+resource "dependencytrack_team" "test" {
+  name = %[1]q
+}
+
+resource "dependencytrack_team_permissions" "test" {
+  team        = dependencytrack_team.test.id
+  permissions = ["VIEW_PORTFOLIO"]
+}
+
+data "dependencytrack_teams" "test" {
+  depends_on = [
+    dependencytrack_team_permissions.test
+  ]
+}
+
+output "test_team" {
+  value = one([
+    for team in data.dependencytrack_teams.test.teams : team
+    if team.id == dependencytrack_team.test.id
+  ])
+}
+`, name)
+}
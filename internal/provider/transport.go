@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// limitedTransport is an http.RoundTripper that caps the number of requests
+// in flight at once. A request holds its slot until its response body is
+// closed (or the round trip fails), so a slow download counts against the
+// limit just like a slow server response. Requests waiting for a slot give up
+// when their context is cancelled.
+type limitedTransport struct {
+	slots chan struct{}
+	next  http.RoundTripper
+}
+
+// newLimitedTransport wraps next so that at most limit requests are in flight
+// at the same time. A nil next uses http.DefaultTransport.
+func newLimitedTransport(limit int, next http.RoundTripper) *limitedTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &limitedTransport{
+		slots: make(chan struct{}, limit),
+		next:  next,
+	}
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	var once sync.Once
+	release := func() { once.Do(func() { <-t.slots }) }
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	if resp.Body == nil {
+		release()
+		return resp, nil
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody calls release once the wrapped response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// Timing knobs for conflictRetryTransport, overridable in unit tests.
+var (
+	conflictRetryAttempts = 5
+	conflictRetryBackoff  = 250 * time.Millisecond
+)
+
+type conflictRetryKey struct{}
+
+// withConflictRetry marks requests made with the returned context as safe to
+// retry on 409 Conflict. Association resources use it for the idempotent
+// requests that add or remove an association: Dependency-Track answers those
+// with 409 when a parallel request updated the same object first, and simply
+// repeating the request succeeds.
+func withConflictRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, conflictRetryKey{}, true)
+}
+
+// conflictRetryTransport is an http.RoundTripper that retries requests marked
+// with withConflictRetry when they get a 409 Conflict response, with a
+// doubling, jittered backoff so parallel requests stop colliding. Other
+// requests are passed through untouched: for most endpoints a 409 reports a
+// real conflict, such as a duplicate name, that retrying cannot resolve. The
+// same goes for a marked request whose 409 says the association already
+// exists (see isExistingAssociation), which is returned right away.
+type conflictRetryTransport struct {
+	next http.RoundTripper
+}
+
+// conflictBodyPeekLimit caps how much of a 409 response body
+// isExistingAssociation reads.
+const conflictBodyPeekLimit = 4096
+
+// isExistingAssociation reports whether a 409 response rejects the request
+// because the association it adds already exists, such as Dependency-Track's
+// "A mapping with the same team and project already exists". Retrying cannot
+// change that. The body is read and then restored, so the caller still sees
+// all of it.
+func isExistingAssociation(resp *http.Response) bool {
+	peek, err := io.ReadAll(io.LimitReader(resp.Body, conflictBodyPeekLimit))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(strings.NewReader(string(peek)), resp.Body), resp.Body}
+	return err == nil && strings.Contains(string(peek), "already exists")
+}
+
+// newConflictRetryTransport wraps next with conflict retries. A nil next uses
+// http.DefaultTransport.
+func newConflictRetryTransport(next http.RoundTripper) *conflictRetryTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &conflictRetryTransport{next: next}
+}
+
+func (t *conflictRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if ctx.Value(conflictRetryKey{}) == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	// client-go sets request bodies without GetBody, so such a body is read
+	// once here to be able to send it again. Association requests are small.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(ctx)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	backoff := conflictRetryBackoff
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if err != nil || resp.StatusCode != http.StatusConflict || attempt >= conflictRetryAttempts {
+			return resp, err
+		}
+		if isExistingAssociation(resp) {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		wait := backoff + time.Duration(rand.Int64N(int64(backoff)/2+1))
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
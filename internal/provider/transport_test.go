@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+func TestLimitedTransport_CapsInFlightRequests(t *testing.T) {
+	const limit = 2
+
+	var inFlight, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newLimitedTransport(limit, nil)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(srv.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > limit {
+		t.Errorf("peak in-flight requests = %d, want at most %d", got, limit)
+	}
+}
+
+func TestLimitedTransport_HoldsSlotUntilBodyClosed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: newLimitedTransport(1, nil)}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The only slot is held by the unclosed body, so a second request must
+	// wait until its context gives up.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded while the slot is held, got: %v", err)
+	}
+
+	_ = resp.Body.Close()
+	// Closing twice must not release a second slot.
+	_ = resp.Body.Close()
+
+	resp, err = client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error after releasing the slot: %s", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestLimitedTransport_ReleasesSlotOnError(t *testing.T) {
+	failing := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+	client := &http.Client{Transport: newLimitedTransport(1, failing)}
+
+	for i := 0; i < 3; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://dtrack.invalid", nil)
+		_, err := client.Do(req)
+		cancel()
+		if err == nil || errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("request %d: expected the transport error, got: %v", i, err)
+		}
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// fastConflictRetries shrinks the conflict retry knobs for the duration of a
+// test so the retry paths don't slow the unit test suite down.
+func fastConflictRetries(t *testing.T) {
+	t.Helper()
+
+	origAttempts, origBackoff := conflictRetryAttempts, conflictRetryBackoff
+	conflictRetryAttempts = 3
+	conflictRetryBackoff = time.Millisecond
+	t.Cleanup(func() {
+		conflictRetryAttempts, conflictRetryBackoff = origAttempts, origBackoff
+	})
+}
+
+func TestConflictRetryTransport(t *testing.T) {
+	tests := []struct {
+		name       string
+		retry      bool
+		conflicts  int32
+		body       string
+		wantCalls  int32
+		wantStatus int
+	}{
+		{name: "no conflict", retry: true, conflicts: 0, wantCalls: 1, wantStatus: http.StatusOK},
+		{name: "transient conflict", retry: true, conflicts: 2, wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "persistent conflict", retry: true, conflicts: 5, wantCalls: 3, wantStatus: http.StatusConflict},
+		{name: "not marked for retry", retry: false, conflicts: 2, wantCalls: 1, wantStatus: http.StatusConflict},
+		{name: "already exists", retry: true, conflicts: 2, body: "A mapping with the same team and project already exists", wantCalls: 1, wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fastConflictRetries(t)
+
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := calls.Add(1)
+				// Every attempt must carry the full request body.
+				if body, _ := io.ReadAll(r.Body); string(body) != `{"team":"a"}` {
+					t.Errorf("attempt %d: got body %q", n, body)
+				}
+				if n <= tt.conflicts {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(tt.body))
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			ctx := context.Background()
+			if tt.retry {
+				ctx = withConflictRetry(ctx)
+			}
+			req, _ := http.NewRequestWithContext(ctx, http.MethodPut, srv.URL, strings.NewReader(`{"team":"a"}`))
+
+			client := &http.Client{Transport: newConflictRetryTransport(nil)}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			body, _ := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d requests, want %d", got, tt.wantCalls)
+			}
+			if resp.StatusCode == http.StatusConflict && string(body) != tt.body {
+				t.Errorf("got body %q, want %q", body, tt.body)
+			}
+		})
+	}
+}
+
+// TestConflictRetryTransport_ClientGoBody checks that requests client-go sends
+// with a body, whose GetBody it leaves unset, are retried too.
+func TestConflictRetryTransport_ClientGoBody(t *testing.T) {
+	fastConflictRetries(t)
+
+	team, project := uuid.New(), uuid.New()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/version" {
+			_, _ = w.Write([]byte(`{"version":"4.14.0"}`))
+			return
+		}
+
+		n := calls.Add(1)
+		var mapping dtrack.ACLMappingRequest
+		if err := json.NewDecoder(r.Body).Decode(&mapping); err != nil || mapping.Team != team || mapping.Project != project {
+			t.Errorf("attempt %d: got mapping %+v (error %v)", n, mapping, err)
+		}
+		if n == 1 {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client, err := dtrack.NewClient(srv.URL, withTransport(newConflictRetryTransport(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = client.ACL.AddProjectMapping(withConflictRetry(context.Background()), dtrack.ACLMappingRequest{Team: team, Project: project})
+	if err != nil {
+		t.Fatalf("AddProjectMapping() = %v, want nil", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("got %d requests, want 2", got)
+	}
+}
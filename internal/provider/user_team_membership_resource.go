@@ -7,11 +7,13 @@ import (
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +22,13 @@ import (
 var _ resource.Resource = &UserTeamMembershipResource{}
 var _ resource.ResourceWithImportState = &UserTeamMembershipResource{}
 
+// User types accepted by the user_type attribute, one per user endpoint.
+const (
+	userTypeManaged = "managed"
+	userTypeLDAP    = "ldap"
+	userTypeOIDC    = "oidc"
+)
+
 func NewUserTeamMembershipResource() resource.Resource {
 	return &UserTeamMembershipResource{}
 }
@@ -34,6 +43,7 @@ type UserTeamMembershipResourceModel struct {
 	ID       types.String `tfsdk:"id"`
 	Username types.String `tfsdk:"username"`
 	Team     types.String `tfsdk:"team"`
+	UserType types.String `tfsdk:"user_type"`
 }
 
 func (r *UserTeamMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +76,18 @@ func (r *UserTeamMembershipResource) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"user_type": schema.StringAttribute{
+				MarkdownDescription: "The kind of user: `managed`, `ldap` or `oidc`. " +
+					"When set, the membership is verified against that user list only, which is much cheaper on large instances. " +
+					"When unset, managed, LDAP and OIDC users are searched in turn.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(userTypeManaged, userTypeLDAP, userTypeOIDC),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -141,7 +163,7 @@ func (r *UserTeamMembershipResource) Read(ctx context.Context, req resource.Read
 	}
 
 	// Verify membership exists
-	exists, err := r.verifyMembership(ctx, data.Username.ValueString(), teamUUID)
+	exists, err := r.verifyMembership(ctx, data.Username.ValueString(), data.UserType.ValueString(), teamUUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to verify team membership, got error: %s", err))
 		return
@@ -195,8 +217,11 @@ func (r *UserTeamMembershipResource) Delete(ctx context.Context, req resource.De
 	// Remove team from user via API
 	err = r.removeTeamFromUser(ctx, data.Username.ValueString(), teamUUID)
 	if err != nil {
-		// The user or team being gone means there is nothing left to delete.
-		if isNotFound(err) {
+		// The user or team being gone (404), or the user no longer being a
+		// member of the team (304), means there is nothing left to delete.
+		// Both happen when the team or user is destroyed before the
+		// membership, or when the state was not refreshed before apply.
+		if isNotFound(err) || isNotModified(err) {
 			return
 		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove team from user, got error: %s", err))
@@ -207,34 +232,52 @@ func (r *UserTeamMembershipResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *UserTeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// The import ID should be in the format "username/team_uuid"
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID in the format 'username/team_uuid', got: %s", req.ID),
-		)
+	username, teamUUID, userType, err := parseUserTeamMembershipImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
-	username := parts[0]
-	teamUUID := parts[1]
-
-	// Validate team UUID format
-	if _, err := uuid.Parse(teamUUID); err != nil {
-		resp.Diagnostics.AddError(
-			"Invalid Team UUID",
-			fmt.Sprintf("The team UUID in the import ID is not valid: %s", err),
-		)
-		return
+	// Without a user type segment user_type stays null, so Read keeps probing
+	// managed, LDAP and OIDC users as it did before user_type existed.
+	if userType != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_type"), userType)...)
 	}
 
 	// Set the attributes
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", username, teamUUID))...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team"), teamUUID)...)
 }
 
+// parseUserTeamMembershipImportID splits an import ID of the form
+// "username/team_uuid" or "username/team_uuid/user_type". userType is empty
+// when the optional segment is omitted.
+func parseUserTeamMembershipImportID(id string) (username, teamUUID, userType string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 && len(parts) != 3 {
+		return "", "", "", fmt.Errorf("expected import ID in the format 'username/team_uuid' or 'username/team_uuid/user_type', got: %s", id)
+	}
+
+	username, teamUUID = parts[0], parts[1]
+	if username == "" {
+		return "", "", "", fmt.Errorf("the username in the import ID is empty: %s", id)
+	}
+
+	if _, err := uuid.Parse(teamUUID); err != nil {
+		return "", "", "", fmt.Errorf("the team UUID in the import ID is not valid: %s", err)
+	}
+
+	if len(parts) == 3 {
+		userType = parts[2]
+		if userType != userTypeManaged && userType != userTypeLDAP && userType != userTypeOIDC {
+			return "", "", "", fmt.Errorf("the user type in the import ID must be one of managed, ldap or oidc, got: %s", userType)
+		}
+	}
+
+	return username, teamUUID, userType, nil
+}
+
 // Helper methods for API calls
 
 func (r *UserTeamMembershipResource) addTeamToUser(ctx context.Context, username string, teamUUID uuid.UUID) error {
@@ -257,45 +300,91 @@ func teamsContain(teams []dtrack.Team, teamUUID uuid.UUID) bool {
 	return false
 }
 
-func (r *UserTeamMembershipResource) verifyMembership(ctx context.Context, username string, teamUUID uuid.UUID) (bool, error) {
-	// A user may be managed, LDAP, or OIDC; check each source in turn.
-	managedUsers, err := fetchAllPages(ctx, r.data.Client.User.GetAllManaged)
+// verifyMembership reports whether username is a member of the team. With a
+// userType only that user list is consulted; otherwise managed, LDAP and
+// OIDC users are searched in turn.
+func (r *UserTeamMembershipResource) verifyMembership(ctx context.Context, username, userType string, teamUUID uuid.UUID) (bool, error) {
+	if userType != "" {
+		found, member, err := r.membershipIn(ctx, userType, username, teamUUID)
+		if err != nil {
+			return false, err
+		}
+		if !found {
+			return false, fmt.Errorf("user not found in %s users: %s", userType, username)
+		}
+		return member, nil
+	}
+
+	found, member, err := r.membershipIn(ctx, userTypeManaged, username, teamUUID)
 	if err != nil {
 		return false, err
 	}
-	for _, user := range managedUsers {
-		if user.Username == username {
-			return teamsContain(user.Teams, teamUUID), nil
-		}
+	if found {
+		return member, nil
 	}
 
 	// Not a managed user - try LDAP. LDAP might not be configured, so log and
 	// fall through to OIDC on error.
-	ldapUsers, err := fetchAllPages(ctx, r.data.Client.LDAP.GetUsers)
+	found, member, err = r.membershipIn(ctx, userTypeLDAP, username, teamUUID)
 	if err != nil {
 		tflog.Debug(ctx, "Failed to fetch LDAP users, trying OIDC", map[string]interface{}{"error": err.Error()})
-	} else {
-		for _, user := range ldapUsers {
-			if user.Username == username {
-				return teamsContain(user.Teams, teamUUID), nil
-			}
-		}
+	} else if found {
+		return member, nil
 	}
 
-	// Try OIDC users. client-go's OIDCService.GetAllUsers takes no PageOptions
-	// (v0.19.0), so it cannot paginate past the server's default page cap; use
-	// the shared paginating HTTP helper against the same endpoint instead.
-	oidcUsers, err := apiGetAllPages[dtrack.OIDCUser](ctx, r.data.API(), "/api/v1/user/oidc", nil)
+	found, member, err = r.membershipIn(ctx, userTypeOIDC, username, teamUUID)
 	if err != nil {
 		// OIDC might not be configured.
 		tflog.Debug(ctx, "Failed to fetch OIDC users", map[string]interface{}{"error": err.Error()})
 		return false, fmt.Errorf("user not found in managed, LDAP, or OIDC users: %s", username)
 	}
-	for _, user := range oidcUsers {
-		if user.Username == username {
-			return teamsContain(user.Teams, teamUUID), nil
-		}
+	if found {
+		return member, nil
 	}
 
 	return false, fmt.Errorf("user not found: %s", username)
 }
+
+// membershipIn looks username up in the user list for userType. found
+// reports whether the user exists there, member whether it is in the team.
+func (r *UserTeamMembershipResource) membershipIn(ctx context.Context, userType, username string, teamUUID uuid.UUID) (found, member bool, err error) {
+	switch userType {
+	case userTypeManaged:
+		users, err := fetchAllPages(ctx, r.data.Client.User.GetAllManaged)
+		if err != nil {
+			return false, false, err
+		}
+		for _, user := range users {
+			if user.Username == username {
+				return true, teamsContain(user.Teams, teamUUID), nil
+			}
+		}
+	case userTypeLDAP:
+		users, err := fetchAllPages(ctx, r.data.Client.LDAP.GetUsers)
+		if err != nil {
+			return false, false, err
+		}
+		for _, user := range users {
+			if user.Username == username {
+				return true, teamsContain(user.Teams, teamUUID), nil
+			}
+		}
+	case userTypeOIDC:
+		// client-go's OIDCService.GetAllUsers takes no PageOptions (v0.19.0),
+		// so it cannot paginate past the server's default page cap; use the
+		// shared paginating HTTP helper against the same endpoint instead.
+		users, err := apiGetAllPages[dtrack.OIDCUser](ctx, r.data.API(), "/api/v1/user/oidc", nil)
+		if err != nil {
+			return false, false, err
+		}
+		for _, user := range users {
+			if user.Username == username {
+				return true, teamsContain(user.Teams, teamUUID), nil
+			}
+		}
+	default:
+		return false, false, fmt.Errorf("unknown user type: %s", userType)
+	}
+
+	return false, false, nil
+}
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
@@ -174,3 +177,97 @@ resource "dependencytrack_user_team_membership" "test" {
 }
 `
 }
+
+func TestAccUserTeamMembershipResource_UserType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheckAPIKey(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the user type pinned to managed users
+			{
+				Config: testAccUserTeamMembershipResourceConfigUserType(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"dependencytrack_user_team_membership.test",
+						tfjsonpath.New("user_type"),
+						knownvalue.StringExact("managed"),
+					),
+				},
+			},
+			// ImportState testing with the user type appended to the ID
+			{
+				ResourceName:      "dependencytrack_user_team_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["dependencytrack_user_team_membership.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.ID + "/managed", nil
+				},
+			},
+		},
+	})
+}
+
+func testAccUserTeamMembershipResourceConfigUserType() string {
+	return testAccProviderConfigWithAPIKey() + `
+resource "dependencytrack_team" "test" {
+  name = "User Type Membership Test Team"
+}
+
+resource "dependencytrack_managed_user" "test" {
+  username = "user-type-membership-test-user"
+  fullname = "User Type Membership Test User"
+  email    = "user-type-membership-test@example.com"
+  password = "Test123!@#"
+}
+
+resource "dependencytrack_user_team_membership" "test" {
+  username  = dependencytrack_managed_user.test.username
+  team      = dependencytrack_team.test.id
+  user_type = "managed"
+}
+`
+}
+
+func TestParseUserTeamMembershipImportID(t *testing.T) {
+	const team = "00000000-0000-0000-0000-000000000001"
+
+	tests := []struct {
+		name         string
+		id           string
+		wantUsername string
+		wantUserType string
+		wantErr      string
+	}{
+		{name: "two segments", id: "john.doe/" + team, wantUsername: "john.doe"},
+		{name: "managed", id: "john.doe/" + team + "/managed", wantUsername: "john.doe", wantUserType: "managed"},
+		{name: "ldap", id: "john.doe/" + team + "/ldap", wantUsername: "john.doe", wantUserType: "ldap"},
+		{name: "oidc", id: "john.doe/" + team + "/oidc", wantUsername: "john.doe", wantUserType: "oidc"},
+		{name: "unknown user type", id: "john.doe/" + team + "/saml", wantErr: "must be one of"},
+		{name: "invalid team", id: "john.doe/not-a-uuid", wantErr: "team UUID"},
+		{name: "empty username", id: "/" + team, wantErr: "username"},
+		{name: "too few segments", id: "john.doe", wantErr: "expected import ID"},
+		{name: "too many segments", id: "john.doe/" + team + "/managed/extra", wantErr: "expected import ID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			username, teamUUID, userType, err := parseUserTeamMembershipImportID(tt.id)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if username != tt.wantUsername || teamUUID != team || userType != tt.wantUserType {
+				t.Errorf("got (%q, %q, %q), want (%q, %q, %q)", username, teamUUID, userType, tt.wantUsername, team, tt.wantUserType)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	// cpe23Regex matches a CPE 2.3 formatted string binding, as given in the
+	// NISTIR 7695 XML schema.
+	cpe23Regex = regexp.MustCompile(`^cpe:2\.3:[aho*\-](:(((\?*|\*?)([a-zA-Z0-9\-._]|(\\[\\*?!"#$%&'()+,/:;<=>@\[\]^` + "`" + `{|}~]))+(\?*|\*?))|[*\-])){5}(:(([a-zA-Z]{2,3}(-([a-zA-Z]{2}|[0-9]{3}))?)|[*\-]))(:(((\?*|\*?)([a-zA-Z0-9\-._]|(\\[\\*?!"#$%&'()+,/:;<=>@\[\]^` + "`" + `{|}~]))+(\?*|\*?))|[*\-])){4}$`)
+
+	// cpe22Regex matches a CPE 2.2 URI binding, which Dependency-Track
+	// accepts alongside 2.3 formatted strings.
+	cpe22Regex = regexp.MustCompile(`^[cC][pP][eE]:/[AHOaho]?(:[A-Za-z0-9._\-~%]*){0,6}$`)
+
+	// purlTypeRegex is the allowed form of a package URL type, per the
+	// package-url specification.
+	purlTypeRegex = regexp.MustCompile(`^[a-zA-Z.+\-][a-zA-Z0-9.+\-]*$`)
+)
+
+// isWellFormedCPE reports whether s is a CPE 2.3 formatted string or a CPE 2.2
+// URI.
+func isWellFormedCPE(s string) bool {
+	return cpe23Regex.MatchString(s) || cpe22Regex.MatchString(s)
+}
+
+// validatePackageURL checks that s has the structure of a package URL:
+// pkg:type/[namespace/]name[@version][?qualifiers][#subpath]. It checks the
+// structure only, not that the type is a known package ecosystem.
+func validatePackageURL(s string) error {
+	rest, ok := strings.CutPrefix(s, "pkg:")
+	if !ok {
+		return fmt.Errorf("must start with the %q scheme", "pkg:")
+	}
+
+	// Subpath and qualifiers come last and may contain slashes or '@'.
+	rest, _, _ = strings.Cut(rest, "#")
+	rest, _, _ = strings.Cut(rest, "?")
+	rest = strings.TrimLeft(rest, "/")
+
+	purlType, remainder, ok := strings.Cut(rest, "/")
+	if !ok || purlType == "" {
+		return fmt.Errorf("must contain a type followed by a name, as in pkg:type/name")
+	}
+	if !purlTypeRegex.MatchString(purlType) {
+		return fmt.Errorf("type %q must start with a letter, '.', '+' or '-' and contain only letters, digits, '.', '+' and '-'", purlType)
+	}
+
+	// The version is separated from the name by the last '@'.
+	if i := strings.LastIndex(remainder, "@"); i >= 0 {
+		if remainder[i+1:] == "" {
+			return fmt.Errorf("version after '@' must not be empty")
+		}
+		remainder = remainder[:i]
+	}
+
+	name := remainder[strings.LastIndex(remainder, "/")+1:]
+	if name == "" {
+		return fmt.Errorf("must contain a package name")
+	}
+
+	return nil
+}
+
+// cpeValidator validates that a string attribute is a well-formed CPE.
+type cpeValidator struct{}
+
+var _ validator.String = cpeValidator{}
+
+func (v cpeValidator) Description(ctx context.Context) string {
+	return "value must be a CPE 2.3 formatted string (cpe:2.3:...) or a CPE 2.2 URI (cpe:/...)"
+}
+
+func (v cpeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cpeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	if !isWellFormedCPE(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CPE",
+			fmt.Sprintf("%q is not a well-formed CPE. Expected a CPE 2.3 formatted string such as "+
+				"cpe:2.3:a:vendor:product:1.0:*:*:*:*:*:*:* or a CPE 2.2 URI such as cpe:/a:vendor:product:1.0.",
+				req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+// purlValidator validates that a string attribute is a structurally valid
+// package URL.
+type purlValidator struct{}
+
+var _ validator.String = purlValidator{}
+
+func (v purlValidator) Description(ctx context.Context) string {
+	return "value must be a package URL (pkg:type/namespace/name@version)"
+}
+
+func (v purlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v purlValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	if err := validatePackageURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Package URL",
+			fmt.Sprintf("%q is not a valid package URL: %s. Expected the form pkg:type/namespace/name@version, "+
+				"e.g. pkg:maven/org.example/app@1.0.0.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// uuidValidator validates that a string attribute is a UUID in the
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx form, in either case.
+type uuidValidator struct{}
+
+var _ validator.String = uuidValidator{}
+
+func (v uuidValidator) Description(ctx context.Context) string {
+	return "value must be a UUID (xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx)"
+}
+
+func (v uuidValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uuidValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	// uuid.Parse also accepts the urn:uuid: and braced forms, which the
+	// Dependency-Track API does not.
+	if _, err := uuid.Parse(value); err != nil || len(value) != 36 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid UUID",
+			fmt.Sprintf("%q is not a UUID. Expected the form xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.", value),
+		)
+	}
+}
+
+// configPropertyKeyValidator checks that a properties map key has the
+// group_name/property_name format.
+type configPropertyKeyValidator struct{}
+
+var _ validator.String = configPropertyKeyValidator{}
+
+func (v configPropertyKeyValidator) Description(ctx context.Context) string {
+	return "key must have the format group_name/property_name"
+}
+
+func (v configPropertyKeyValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v configPropertyKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, _, err := parseConfigPropertyID(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Config Property Key",
+			fmt.Sprintf("Expected a key in the format 'group_name/property_name', got: %s\nError: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// cronField describes one field of a five-field cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	// names maps the case-insensitive names allowed in place of numbers.
+	names map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// validateCronExpression checks that s is a standard five-field cron
+// expression (minute, hour, day of month, month, day of week), where each
+// field is a comma-separated list of *, values or ranges, optionally with a
+// /step.
+func validateCronExpression(s string) error {
+	fields := strings.Fields(s)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("must have 5 space-separated fields (minute, hour, day of month, month, day of week), got %d", len(fields))
+	}
+
+	for i, field := range fields {
+		if err := cronFields[i].validate(field); err != nil {
+			return fmt.Errorf("%s field %q: %w", cronFields[i].name, field, err)
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) validate(field string) error {
+	for _, item := range strings.Split(field, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return fmt.Errorf("step %q must be a positive number", step)
+			}
+		}
+
+		if base == "*" {
+			continue
+		}
+
+		from, to, isRange := strings.Cut(base, "-")
+		low, err := f.value(from)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		high, err := f.value(to)
+		if err != nil {
+			return err
+		}
+		if low > high {
+			return fmt.Errorf("range %q runs backwards", base)
+		}
+	}
+
+	return nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("%q is not a value between %d and %d", s, f.min, f.max)
+	}
+	return n, nil
+}
+
+// cronValidator validates that a string attribute is a five-field cron
+// expression.
+type cronValidator struct{}
+
+var _ validator.String = cronValidator{}
+
+func (v cronValidator) Description(ctx context.Context) string {
+	return "value must be a five-field cron expression (minute hour day-of-month month day-of-week)"
+}
+
+func (v cronValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateCronExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Cron Expression",
+			fmt.Sprintf("%q is not a valid cron expression: %s. Expected five fields such as \"0 8 * * MON-FRI\".",
+				req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIsWellFormedCPE(t *testing.T) {
+	tests := []struct {
+		cpe  string
+		want bool
+	}{
+		{cpe: "cpe:2.3:a:apache:log4j:2.14.1:*:*:*:*:*:*:*", want: true},
+		{cpe: "cpe:2.3:o:linux:linux_kernel:5.10:-:*:*:*:*:*:*", want: true},
+		{cpe: `cpe:2.3:a:foo\!bar:product:1.0:*:*:*:*:*:*:*`, want: true},
+		{cpe: "cpe:/a:apache:log4j:2.14.1", want: true},
+		{cpe: "cpe:/o:microsoft:windows_10", want: true},
+		{cpe: "cpe:2.3:a:apache:log4j:2.14.1", want: false},
+		{cpe: "cpe:2.3:x:apache:log4j:2.14.1:*:*:*:*:*:*:*", want: false},
+		{cpe: "apache:log4j:2.14.1", want: false},
+		{cpe: "cpe:2.3:a:apache:log 4j:2.14.1:*:*:*:*:*:*:*", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cpe, func(t *testing.T) {
+			if got := isWellFormedCPE(tt.cpe); got != tt.want {
+				t.Errorf("isWellFormedCPE(%q) = %v, want %v", tt.cpe, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePackageURL(t *testing.T) {
+	tests := []struct {
+		purl    string
+		wantErr bool
+	}{
+		{purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+		{purl: "pkg:npm/%40angular/core@16.0.0"},
+		{purl: "pkg:npm/left-pad"},
+		{purl: "pkg:golang/github.com/google/uuid@v1.6.0"},
+		{purl: "pkg:docker/library/nginx@sha256:abc?repository_url=docker.io"},
+		{purl: "pkg:github/package-url/purl-spec@244fd47#everybody/loves/dogs"},
+		{purl: "pkg:deb/debian/curl@7.50.3-1?arch=i386&distro=jessie"},
+		{purl: "maven/org.example/app@1.0.0", wantErr: true},
+		{purl: "pkg:maven", wantErr: true},
+		{purl: "pkg:/app@1.0.0", wantErr: true},
+		{purl: "pkg:1maven/org.example/app", wantErr: true},
+		{purl: "pkg:maven/org.example/@1.0.0", wantErr: true},
+		{purl: "pkg:npm/left-pad@", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			err := validatePackageURL(tt.purl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePackageURL(%q) error = %v, wantErr %v", tt.purl, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCPEAndPURLValidators_SkipEmptyAndUnknown(t *testing.T) {
+	values := []types.String{types.StringNull(), types.StringUnknown(), types.StringValue("")}
+	validators := []validator.String{cpeValidator{}, purlValidator{}}
+
+	for _, v := range validators {
+		for _, value := range values {
+			req := validator.StringRequest{Path: path.Root("attr"), ConfigValue: value}
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("%T rejected %s: %v", v, value, resp.Diagnostics)
+			}
+		}
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "0 8 * * MON-FRI"},
+		{expr: "*/15 * * * *"},
+		{expr: "0 0 1 jan,jul *"},
+		{expr: "30 6-18/2 * * 0"},
+		{expr: "0 8 * *", wantErr: true},
+		{expr: "0 8 * * * *", wantErr: true},
+		{expr: "60 * * * *", wantErr: true},
+		{expr: "0 24 * * *", wantErr: true},
+		{expr: "0 0 0 * *", wantErr: true},
+		{expr: "0 0 * * FUN", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+		{expr: "0 18-6 * * *", wantErr: true},
+		{expr: "@daily", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			err := validateCronExpression(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateCronExpression(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUUIDValidator(t *testing.T) {
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("0b9e3c39-2e4c-4a8b-9f1d-6c2a7e5b4d10")},
+		{value: types.StringValue("0B9E3C39-2E4C-4A8B-9F1D-6C2A7E5B4D10")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue(""), wantErr: true},
+		{value: types.StringValue("not-a-uuid"), wantErr: true},
+		{value: types.StringValue("{0b9e3c39-2e4c-4a8b-9f1d-6c2a7e5b4d10}"), wantErr: true},
+		{value: types.StringValue("urn:uuid:0b9e3c39-2e4c-4a8b-9f1d-6c2a7e5b4d10"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("attr"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			uuidValidator{}.ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateString(%s) error = %v, wantErr %v", tt.value, resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}